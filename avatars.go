@@ -0,0 +1,75 @@
+package gogitlab
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// Upload an avatar as a multipart form and decode the updated resource into v
+func (g *Gitlab) uploadAvatar(u string, params map[string]string, filename string, avatar io.Reader, v interface{}) error {
+	if "" == filename || nil == avatar {
+		return fmt.Errorf("Check avatar parameters error: Missing avatar file")
+	}
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	part, err := w.CreateFormFile("avatar", filename)
+	if nil != err {
+		return fmt.Errorf("Encode request error: %v", err)
+	}
+	if _, err := io.Copy(part, avatar); nil != err {
+		return fmt.Errorf("Encode request error: %v", err)
+	}
+	if err := w.Close(); nil != err {
+		return fmt.Errorf("Encode request error: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPut, g.ResourceUrl(u, params), &body)
+	if nil != err {
+		return fmt.Errorf("Build request error: %v", err)
+	}
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	resp, err := g.doRequest(req)
+	if nil != err {
+		return fmt.Errorf("Request upload avatar API error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(v); nil != err {
+		return fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return nil
+}
+
+/*
+Upload the avatar of a project, UpdateProject can not send files.
+
+Parameters:
+
+	id       The ID or NAME of a project
+	filename The name of the image file, GitLab checks its extension
+	avatar   The content of the image
+
+*/
+func (g *Gitlab) UpdateProjectAvatar(id, filename string, avatar io.Reader) (*Project, error) {
+	var project *Project
+	if err := g.uploadAvatar(project_url, map[string]string{":id": id}, filename, avatar, &project); nil != err {
+		return nil, err
+	}
+	return project, nil
+}
+
+// Upload the avatar of a group, see UpdateProjectAvatar for the parameters
+func (g *Gitlab) UpdateGroupAvatar(id, filename string, avatar io.Reader) (*Group, error) {
+	var group *Group
+	if err := g.uploadAvatar(group_url, map[string]string{":id": id}, filename, avatar, &group); nil != err {
+		return nil, err
+	}
+	return group, nil
+}
@@ -0,0 +1,94 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+)
+
+var (
+	issueAwardEmojisUrl = path.Join(project_issue_url, "award_emoji")
+	issueAwardEmojiUrl  = path.Join(project_issue_url, "award_emoji", ":award_id")
+)
+
+// An emoji reaction on an issue, a merge request or a snippet
+type AwardEmoji struct {
+	Id            int        `json:"id"`
+	Name          string     `json:"name"` // Without colons, like thumbsup
+	User          *User      `json:"user"`
+	CreatedAt     *time.Time `json:"created_at"`
+	UpdatedAt     *time.Time `json:"updated_at"`
+	AwardableId   int        `json:"awardable_id"`
+	AwardableType string     `json:"awardable_type"`
+}
+
+func (g *Gitlab) listAwardEmojis(u string, params map[string]string, page *Pagination) ([]*AwardEmoji, error) {
+	query, err := paginationQuery(page)
+	if nil != err {
+		return nil, fmt.Errorf("Check list award emojis parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(http.MethodGet, g.ResourceUrlWithQuery(u, params, query), nil)
+	if nil != err {
+		return nil, fmt.Errorf("Request list award emojis API error: %v", err)
+	}
+
+	var es []*AwardEmoji
+	if err := json.Unmarshal(data, &es); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return es, nil
+}
+
+func (g *Gitlab) execAwardEmoji(method, u string, params, query map[string]string) (*AwardEmoji, error) {
+	data, err := g.buildAndExecRequest(method, g.ResourceUrlWithQuery(u, params, query), nil)
+	if nil != err {
+		return nil, fmt.Errorf("Request award emoji API error: %v", err)
+	}
+
+	var e *AwardEmoji
+	if err := json.Unmarshal(data, &e); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return e, nil
+}
+
+func (g *Gitlab) deleteAwardEmoji(u string, params map[string]string) error {
+	_, err := g.buildAndExecRequest(http.MethodDelete, g.ResourceUrl(u, params), nil)
+	if nil != err {
+		err = fmt.Errorf("Request delete award emoji API error: %v", err)
+	}
+
+	return err
+}
+
+func issueAwardEmojiParams(projectId string, issueIid, awardId int) map[string]string {
+	params := issueParams(projectId, issueIid)
+	params[":award_id"] = strconv.Itoa(awardId)
+	return params
+}
+
+func (g *Gitlab) IssueAwardEmojis(projectId string, issueIid int, page *Pagination) ([]*AwardEmoji, error) {
+	return g.listAwardEmojis(issueAwardEmojisUrl, issueParams(projectId, issueIid), page)
+}
+
+func (g *Gitlab) IssueAwardEmoji(projectId string, issueIid, awardId int) (*AwardEmoji, error) {
+	return g.execAwardEmoji(http.MethodGet, issueAwardEmojiUrl, issueAwardEmojiParams(projectId, issueIid, awardId), nil)
+}
+
+// React to an issue as the authenticated user, name is like thumbsup
+func (g *Gitlab) CreateIssueAwardEmoji(projectId string, issueIid int, name string) (*AwardEmoji, error) {
+	if "" == name {
+		return nil, fmt.Errorf("Check create award emoji parameters error: Missing name")
+	}
+	return g.execAwardEmoji(http.MethodPost, issueAwardEmojisUrl, issueParams(projectId, issueIid), map[string]string{"name": name})
+}
+
+func (g *Gitlab) DeleteIssueAwardEmoji(projectId string, issueIid, awardId int) error {
+	return g.deleteAwardEmoji(issueAwardEmojiUrl, issueAwardEmojiParams(projectId, issueIid, awardId))
+}
@@ -0,0 +1,56 @@
+package gogitlab
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueAwardEmojis(t *testing.T) {
+	ts, gitlab := Stub("stubs/award_emoji/index.json")
+	defer ts.Close()
+
+	es, err := gitlab.IssueAwardEmojis("4", 14, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(es), 2)
+	assert.Equal(t, es[1].Name, "microphone")
+	assert.Equal(t, es[1].User.Username, "user4")
+	assert.Equal(t, es[1].AwardableType, "Issue")
+}
+
+func TestCreateIssueAwardEmoji(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/award_emoji/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/4/issues/14/award_emoji")
+		assert.Equal(t, r.URL.Query().Get("name"), "blowfish")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	e, err := gitlab.CreateIssueAwardEmoji("4", 14, "blowfish")
+
+	assert.NoError(t, err)
+	assert.Equal(t, e.Id, 344)
+	assert.Equal(t, e.Name, "blowfish")
+
+	_, err = gitlab.CreateIssueAwardEmoji("4", 14, "")
+	assert.EqualError(t, err, "Check create award emoji parameters error: Missing name")
+}
+
+func TestDeleteIssueAwardEmoji(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodDelete)
+		assert.Equal(t, r.URL.Path, "/projects/4/issues/14/award_emoji/344")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	assert.NoError(t, gitlab.DeleteIssueAwardEmoji("4", 14, 344))
+}
@@ -0,0 +1,223 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+)
+
+var (
+	projectBadgesUrl      = path.Join(project_url, "badges")
+	projectBadgeUrl       = path.Join(project_url, "badges", ":badge_id")
+	projectBadgeRenderUrl = path.Join(project_url, "badges", "render")
+	groupBadgesUrl        = path.Join(group_url, "badges")
+	groupBadgeUrl         = path.Join(group_url, "badges", ":badge_id")
+	groupBadgeRenderUrl   = path.Join(group_url, "badges", "render")
+)
+
+const (
+	BadgeKindProject = "project"
+	BadgeKindGroup   = "group" // Inherited from the group of the project
+)
+
+/*
+A badge of a project or a group.
+
+LinkURL and ImageURL may contain placeholders, e.g. %{project_path},
+%{default_branch} or %{commit_sha}, the rendered URLs have them replaced.
+*/
+type Badge struct {
+	Id               int    `json:"id"`
+	Name             string `json:"name"`
+	LinkURL          string `json:"link_url"`
+	ImageURL         string `json:"image_url"`
+	RenderedLinkURL  string `json:"rendered_link_url"`
+	RenderedImageURL string `json:"rendered_image_url"`
+	Kind             string `json:"kind"`
+}
+
+// Parameters of a badge, empty fields are left unchanged on edit
+type BadgeOpts struct {
+	Name     string `json:"name,omitempty"`
+	LinkURL  string `json:"link_url,omitempty"`
+	ImageURL string `json:"image_url,omitempty"`
+}
+
+type ListBadgesOpts struct {
+	// Only list the badges with this name
+	Name string
+	Pagination
+}
+
+func (opts *ListBadgesOpts) toQuery() (map[string]string, error) {
+	if nil == opts {
+		return nil, nil
+	}
+
+	if err := opts.Pagination.check(); nil != err {
+		return nil, err
+	}
+
+	query := make(map[string]string)
+	if "" != opts.Name {
+		query["name"] = opts.Name
+	}
+	opts.Pagination.toQuery(query)
+	return query, nil
+}
+
+func (opts *BadgeOpts) check() error {
+	if nil == opts {
+		return fmt.Errorf("Missing badge parameters")
+	}
+	return nil
+}
+
+func (g *Gitlab) listBadges(u string, params map[string]string, opts *ListBadgesOpts) ([]*Badge, error) {
+	query, err := opts.toQuery()
+	if nil != err {
+		return nil, fmt.Errorf("Check list badges parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(u, params, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list badges API error: %v", err)
+	}
+
+	var bs []*Badge
+	if err := json.Unmarshal(data, &bs); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return bs, nil
+}
+
+func (g *Gitlab) execBadge(method, u string, params map[string]string, opts *BadgeOpts) (*Badge, error) {
+	var body []byte
+	if nil != opts {
+		var err error
+		if body, err = json.Marshal(opts); nil != err {
+			return nil, fmt.Errorf("Encode request error: %v", err)
+		}
+	}
+
+	data, err := g.buildAndExecRequest(method, g.ResourceUrl(u, params), body)
+	if nil != err {
+		return nil, fmt.Errorf("Request badge API error: %v", err)
+	}
+
+	var b *Badge
+	if err := json.Unmarshal(data, &b); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return b, nil
+}
+
+func (g *Gitlab) removeBadge(u string, params map[string]string) error {
+	_, err := g.buildAndExecRequest(http.MethodDelete, g.ResourceUrl(u, params), nil)
+	if nil != err {
+		err = fmt.Errorf("Request delete badge API error: %v", err)
+	}
+
+	return err
+}
+
+func (g *Gitlab) renderBadge(u string, params map[string]string, linkURL, imageURL string) (*Badge, error) {
+	if "" == linkURL || "" == imageURL {
+		return nil, fmt.Errorf("Check render badge parameters error: Missing link_url or image_url")
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(u, params, map[string]string{
+			"link_url":  linkURL,
+			"image_url": imageURL,
+		}),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request render badge API error: %v", err)
+	}
+
+	var b *Badge
+	if err := json.Unmarshal(data, &b); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return b, nil
+}
+
+func badgeParams(id string, badgeId int) map[string]string {
+	return map[string]string{":id": id, ":badge_id": strconv.Itoa(badgeId)}
+}
+
+// List the badges of a project, including the ones inherited from its group
+func (g *Gitlab) ProjectBadges(pid string, opts *ListBadgesOpts) ([]*Badge, error) {
+	return g.listBadges(projectBadgesUrl, map[string]string{":id": pid}, opts)
+}
+
+func (g *Gitlab) ProjectBadge(pid string, badgeId int) (*Badge, error) {
+	return g.execBadge(http.MethodGet, projectBadgeUrl, badgeParams(pid, badgeId), nil)
+}
+
+func (g *Gitlab) AddProjectBadge(pid string, opts *BadgeOpts) (*Badge, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check add badge parameters error: %v", err)
+	}
+	return g.execBadge(http.MethodPost, projectBadgesUrl, map[string]string{":id": pid}, opts)
+}
+
+func (g *Gitlab) EditProjectBadge(pid string, badgeId int, opts *BadgeOpts) (*Badge, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check edit badge parameters error: %v", err)
+	}
+	return g.execBadge(http.MethodPut, projectBadgeUrl, badgeParams(pid, badgeId), opts)
+}
+
+// Delete a project badge, inherited group badges can not be deleted this way
+func (g *Gitlab) DeleteProjectBadge(pid string, badgeId int) error {
+	return g.removeBadge(projectBadgeUrl, badgeParams(pid, badgeId))
+}
+
+// Preview the URLs of a badge rendered for a project, without creating it
+func (g *Gitlab) RenderProjectBadge(pid, linkURL, imageURL string) (*Badge, error) {
+	return g.renderBadge(projectBadgeRenderUrl, map[string]string{":id": pid}, linkURL, imageURL)
+}
+
+func (g *Gitlab) GroupBadges(gid string, opts *ListBadgesOpts) ([]*Badge, error) {
+	return g.listBadges(groupBadgesUrl, map[string]string{":id": gid}, opts)
+}
+
+func (g *Gitlab) GroupBadge(gid string, badgeId int) (*Badge, error) {
+	return g.execBadge(http.MethodGet, groupBadgeUrl, badgeParams(gid, badgeId), nil)
+}
+
+func (g *Gitlab) AddGroupBadge(gid string, opts *BadgeOpts) (*Badge, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check add badge parameters error: %v", err)
+	}
+	return g.execBadge(http.MethodPost, groupBadgesUrl, map[string]string{":id": gid}, opts)
+}
+
+func (g *Gitlab) EditGroupBadge(gid string, badgeId int, opts *BadgeOpts) (*Badge, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check edit badge parameters error: %v", err)
+	}
+	return g.execBadge(http.MethodPut, groupBadgeUrl, badgeParams(gid, badgeId), opts)
+}
+
+func (g *Gitlab) DeleteGroupBadge(gid string, badgeId int) error {
+	return g.removeBadge(groupBadgeUrl, badgeParams(gid, badgeId))
+}
+
+// Preview the URLs of a badge rendered for a group, without creating it
+func (g *Gitlab) RenderGroupBadge(gid, linkURL, imageURL string) (*Badge, error) {
+	return g.renderBadge(groupBadgeRenderUrl, map[string]string{":id": gid}, linkURL, imageURL)
+}
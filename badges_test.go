@@ -0,0 +1,99 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectBadges(t *testing.T) {
+	ts, gitlab := Stub("stubs/badges/index.json")
+	defer ts.Close()
+
+	badges, err := gitlab.ProjectBadges("1", &ListBadgesOpts{Name: "Coverage"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(badges), 2)
+	assert.Equal(t, badges[0].Name, "Coverage")
+	assert.Equal(t, badges[0].Kind, BadgeKindProject)
+	assert.Equal(t, badges[1].Kind, BadgeKindGroup)
+	assert.Equal(t, badges[1].RenderedImageURL, "http://example.com/example-org/example-project/badges/master/pipeline.svg")
+}
+
+func TestAddProjectBadge(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/badges/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/1/badges")
+
+		var opts BadgeOpts
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&opts))
+		assert.Equal(t, opts.ImageURL, "https://shields.io/my/badge")
+
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	badge, err := gitlab.AddProjectBadge("1", &BadgeOpts{
+		LinkURL:  "http://example.com/ci_status.svg?project=%{project_path}&ref=%{default_branch}",
+		ImageURL: "https://shields.io/my/badge",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, badge.Id, 1)
+
+	_, err = gitlab.AddProjectBadge("1", nil)
+	assert.Error(t, err)
+}
+
+func TestEditGroupBadge(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/badges/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPut)
+		assert.Equal(t, r.URL.Path, "/groups/2/badges/1")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	badge, err := gitlab.EditGroupBadge("2", 1, &BadgeOpts{Name: "Coverage"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, badge.Name, "Coverage")
+}
+
+func TestDeleteProjectBadge(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodDelete)
+		assert.Equal(t, r.URL.Path, "/projects/1/badges/1")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	assert.NoError(t, gitlab.DeleteProjectBadge("1", 1))
+}
+
+func TestRenderProjectBadge(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/badges/render.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/projects/1/badges/render")
+		assert.Equal(t, r.URL.Query().Get("image_url"), "https://shields.io/my/badge")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	badge, err := gitlab.RenderProjectBadge("1", "http://example.com/ci_status.svg?project=%{project_path}&ref=%{default_branch}", "https://shields.io/my/badge")
+
+	assert.NoError(t, err)
+	assert.Equal(t, badge.RenderedLinkURL, "http://example.com/ci_status.svg?project=example-org/example-project&ref=master")
+
+	_, err = gitlab.RenderProjectBadge("1", "", "")
+	assert.Error(t, err)
+}
@@ -1,14 +1,56 @@
 package gogitlab
 
 import (
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
 	"time"
 )
 
 const (
-	commit_status = "/projects/:id/repository/commits/:sha/statuses" // Get the statuses of a commit in a project
+	commit_status         = "/projects/:id/repository/commits/:sha/statuses"       // Get the statuses of a commit in a project
+	commit_url            = "/projects/:id/repository/commits/:sha"                // Get a specific commit of a project
+	commit_diff           = "/projects/:id/repository/commits/:sha/diff"           // Get the diff of a commit in a project
+	commit_refs           = "/projects/:id/repository/commits/:sha/refs"           // Get the branches and tags a commit is pushed to
+	commit_comments       = "/projects/:id/repository/commits/:sha/comments"       // Get the comments of a commit in a project
+	commit_merge_requests = "/projects/:id/repository/commits/:sha/merge_requests" // Get the merge requests related to a commit
+	commit_cherry_pick    = "/projects/:id/repository/commits/:sha/cherry_pick"    // Cherry-pick a commit into a branch
+	commit_revert         = "/projects/:id/repository/commits/:sha/revert"         // Revert a commit in a branch
 )
 
+const (
+	CommitRefBranch = "branch"
+	CommitRefTag    = "tag"
+	CommitRefAll    = "all"
+)
+
+// A branch or a tag containing a commit
+type CommitRef struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type CommitComment struct {
+	Note      string     `json:"note"`
+	Author    *User      `json:"author,omitempty"`
+	Path      string     `json:"path,omitempty"`
+	Line      int        `json:"line,omitempty"`
+	LineType  string     `json:"line_type,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// A comment to post on a commit, Path, Line and LineType
+// attach it to a line of the diff
+type CommitCommentOpts struct {
+	Note string `json:"note"`
+	Path string `json:"path,omitempty"`
+	Line int    `json:"line,omitempty"`
+	// Either `new` or `old`, the side of the diff Line refers to
+	LineType string `json:"line_type,omitempty"`
+}
+
 type CommitStatus struct {
 	Status       string     `json:"status"`
 	CreatedAt    time.Time  `json:"created_at"`
@@ -41,3 +83,459 @@ func (g *Gitlab) ProjectCommitStatuses(id, sha1 string) ([]*CommitStatus, error)
 
 	return statuses, err
 }
+
+func commitParams(id, sha string) map[string]string {
+	return map[string]string{
+		":id":  id,
+		":sha": url.PathEscape(sha),
+	}
+}
+
+/*
+Get a specific commit identified by the commit hash or name of a branch or tag.
+
+    GET /projects/:id/repository/commits/:sha
+
+Parameters:
+
+    id  The ID of a project
+    sha The commit hash or name of a repository branch or tag
+
+*/
+func (g *Gitlab) RepoCommit(id, sha string) (*Commit, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrl(commit_url, commitParams(id, sha)),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request get commit API error: %v", err)
+	}
+
+	var c *Commit
+	if err := json.Unmarshal(data, &c); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return c, nil
+}
+
+/*
+Get the diff of a commit.
+
+    GET /projects/:id/repository/commits/:sha/diff
+
+Parameters:
+
+    id  The ID of a project
+    sha The commit hash or name of a repository branch or tag
+
+*/
+func (g *Gitlab) RepoCommitDiff(id, sha string, page *Pagination) ([]*ChangeItem, error) {
+	query, err := paginationQuery(page)
+	if nil != err {
+		return nil, fmt.Errorf("Check commit diff parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(commit_diff, commitParams(id, sha), query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request get commit diff API error: %v", err)
+	}
+
+	var diffs []*ChangeItem
+	if err := json.Unmarshal(data, &diffs); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return diffs, nil
+}
+
+/*
+Get all references (from branches or tags) a commit is pushed to.
+
+    GET /projects/:id/repository/commits/:sha/refs
+
+Parameters:
+
+    id      The ID of a project
+    sha     The commit hash
+    refType The scope of commits, `branch`, `tag` or `all`, defaults to `all`
+
+*/
+func (g *Gitlab) RepoCommitRefs(id, sha, refType string) ([]*CommitRef, error) {
+	var query map[string]string
+	switch refType {
+	case "":
+	case CommitRefBranch, CommitRefTag, CommitRefAll:
+		query = map[string]string{"type": refType}
+	default:
+		return nil, fmt.Errorf("Check commit refs parameters error: Invalid type '%s'", refType)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(commit_refs, commitParams(id, sha), query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request get commit refs API error: %v", err)
+	}
+
+	var refs []*CommitRef
+	if err := json.Unmarshal(data, &refs); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return refs, nil
+}
+
+/*
+Get the comments of a commit.
+
+    GET /projects/:id/repository/commits/:sha/comments
+
+Parameters:
+
+    id  The ID of a project
+    sha The commit hash or name of a repository branch or tag
+
+*/
+func (g *Gitlab) RepoCommitComments(id, sha string) ([]*CommitComment, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrl(commit_comments, commitParams(id, sha)),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request get commit comments API error: %v", err)
+	}
+
+	var comments []*CommitComment
+	if err := json.Unmarshal(data, &comments); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return comments, nil
+}
+
+func (opts *CommitCommentOpts) check() error {
+	if nil == opts || "" == opts.Note {
+		return fmt.Errorf("Missing note")
+	}
+
+	if "" != opts.LineType && "new" != opts.LineType && "old" != opts.LineType {
+		return fmt.Errorf("Invalid line_type '%s'", opts.LineType)
+	}
+
+	if (opts.Line > 0 || "" != opts.LineType) && "" == opts.Path {
+		return fmt.Errorf("Missing path of line %d", opts.Line)
+	}
+
+	return nil
+}
+
+/*
+Add a comment to a commit, optionally on a line of its diff.
+
+    POST /projects/:id/repository/commits/:sha/comments
+
+Parameters:
+
+    id  The ID of a project
+    sha The commit hash or name of a repository branch or tag
+
+*/
+func (g *Gitlab) PostCommitComment(id, sha string, opts *CommitCommentOpts) (*CommitComment, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check commit comment parameters error: %v", err)
+	}
+
+	body, err := json.Marshal(opts)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodPost,
+		g.ResourceUrl(commit_comments, commitParams(id, sha)),
+		body,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request post commit comment API error: %v", err)
+	}
+
+	var c *CommitComment
+	if err := json.Unmarshal(data, &c); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return c, nil
+}
+
+/*
+Get the merge requests which introduced a commit.
+
+    GET /projects/:id/repository/commits/:sha/merge_requests
+
+Parameters:
+
+    id  The ID of a project
+    sha The commit hash
+
+*/
+func (g *Gitlab) RepoCommitMergeRequests(id, sha string) ([]*MergeRequest, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrl(commit_merge_requests, commitParams(id, sha)),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request get commit merge requests API error: %v", err)
+	}
+
+	var mrs []*MergeRequest
+	if err := json.Unmarshal(data, &mrs); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return mrs, nil
+}
+
+// Error returned by GitLab when a commit cannot be cherry-picked or reverted
+type CommitPickError struct {
+	Status    int    `json:"-"`
+	Message   string `json:"message"`
+	ErrorCode string `json:"error_code"`
+}
+
+func (e *CommitPickError) Error() string {
+	return fmt.Sprintf("Gitlab response error: (%d)%s %s", e.Status, e.ErrorCode, e.Message)
+}
+
+// Whether the cherry-pick or revert failed because of conflicts
+func (e *CommitPickError) Conflict() bool {
+	return "conflict" == e.ErrorCode
+}
+
+func IsCommitConflictErr(err error) bool {
+	pe, ok := err.(*CommitPickError)
+	return ok && pe.Conflict()
+}
+
+func (g *Gitlab) pickCommit(u, action, id, sha, branch string, dryRun bool) (*Commit, error) {
+	if "" == branch {
+		return nil, fmt.Errorf("Check %s commit parameters error: Missing branch", action)
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"branch":  branch,
+		"dry_run": dryRun,
+	})
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodPost,
+		g.ResourceUrl(u, commitParams(id, sha)),
+		body,
+	)
+	if re, ok := err.(*respErr); ok && http.StatusBadRequest == re.status {
+		pe := &CommitPickError{Status: re.status}
+		if nil == json.Unmarshal([]byte(re.msg), pe) && "" != pe.ErrorCode {
+			return nil, pe
+		}
+	}
+	if nil != err {
+		return nil, fmt.Errorf("Request %s commit API error: %v", action, err)
+	}
+
+	if dryRun {
+		return nil, nil
+	}
+
+	var c *Commit
+	if err := json.Unmarshal(data, &c); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return c, nil
+}
+
+/*
+Cherry-pick a commit into a branch.
+
+    POST /projects/:id/repository/commits/:sha/cherry_pick
+
+Parameters:
+
+    id     The ID of a project
+    sha    The commit hash
+    branch The name of the branch to cherry-pick the commit into
+    dryRun Only check the commit can be cherry-picked, no commit is returned
+
+A *CommitPickError is returned when GitLab refuses the cherry-pick,
+IsCommitConflictErr tells if it is because of conflicts.
+*/
+func (g *Gitlab) CherryPickCommit(id, sha, branch string, dryRun bool) (*Commit, error) {
+	return g.pickCommit(commit_cherry_pick, "cherry-pick", id, sha, branch, dryRun)
+}
+
+/*
+Revert a commit in a branch.
+
+    POST /projects/:id/repository/commits/:sha/revert
+
+Parameters:
+
+    id     The ID of a project
+    sha    The commit hash
+    branch The name of the branch to revert the commit in
+    dryRun Only check the commit can be reverted, no commit is returned
+
+A *CommitPickError is returned when GitLab refuses the revert,
+IsCommitConflictErr tells if it is because of conflicts.
+*/
+func (g *Gitlab) RevertCommit(id, sha, branch string, dryRun bool) (*Commit, error) {
+	return g.pickCommit(commit_revert, "revert", id, sha, branch, dryRun)
+}
+
+const (
+	CommitActionCreate = "create"
+	CommitActionUpdate = "update"
+	CommitActionMove   = "move"
+	CommitActionDelete = "delete"
+	CommitActionChmod  = "chmod"
+)
+
+// A file change of a multi-files commit
+type CommitAction struct {
+	Action   string `json:"action"`
+	FilePath string `json:"file_path"`
+	// Original path of a moved file
+	PreviousPath    string `json:"previous_path,omitempty"`
+	Content         string `json:"content,omitempty"`
+	Encoding        string `json:"encoding,omitempty"`
+	LastCommitId    string `json:"last_commit_id,omitempty"`
+	ExecuteFilemode *bool  `json:"execute_filemode,omitempty"`
+}
+
+// Set the content of the action from raw bytes, using base64 encoding
+func (a *CommitAction) SetContent(data []byte) {
+	a.Content = base64.StdEncoding.EncodeToString(data)
+	a.Encoding = FileEncodingBase64
+}
+
+type CreateCommitOpts struct {
+	Branch        string `json:"branch"`
+	CommitMessage string `json:"commit_message"`
+	// Branch, commit or project to start the new branch from,
+	// only used when Branch does not exist yet
+	StartBranch  string          `json:"start_branch,omitempty"`
+	StartSHA     string          `json:"start_sha,omitempty"`
+	StartProject string          `json:"start_project,omitempty"`
+	Actions      []*CommitAction `json:"actions"`
+	AuthorEmail  string          `json:"author_email,omitempty"`
+	AuthorName   string          `json:"author_name,omitempty"`
+	Stats        *bool           `json:"stats,omitempty"`
+	// Overwrite Branch with a commit based on StartBranch or StartSHA
+	Force bool `json:"force,omitempty"`
+}
+
+var validCommitAction = map[string]bool{
+	CommitActionCreate: true,
+	CommitActionUpdate: true,
+	CommitActionMove:   true,
+	CommitActionDelete: true,
+	CommitActionChmod:  true,
+}
+
+func (a *CommitAction) check() error {
+	if nil == a {
+		return fmt.Errorf("Missing action")
+	}
+
+	if !validCommitAction[a.Action] {
+		return fmt.Errorf("Invalid action '%s'", a.Action)
+	}
+
+	if "" == a.FilePath {
+		return fmt.Errorf("Missing file_path of %s action", a.Action)
+	}
+
+	if "" != a.Encoding && FileEncodingText != a.Encoding && FileEncodingBase64 != a.Encoding {
+		return fmt.Errorf("Invalid encoding '%s' of %s", a.Encoding, a.FilePath)
+	}
+
+	if CommitActionMove == a.Action && "" == a.PreviousPath {
+		return fmt.Errorf("Missing previous_path of %s", a.FilePath)
+	}
+
+	if CommitActionChmod == a.Action && nil == a.ExecuteFilemode {
+		return fmt.Errorf("Missing execute_filemode of %s", a.FilePath)
+	}
+
+	return nil
+}
+
+func (opts *CreateCommitOpts) check() error {
+	if nil == opts {
+		return fmt.Errorf("Missing commit parameters")
+	}
+
+	if "" == opts.Branch {
+		return fmt.Errorf("Missing branch")
+	}
+
+	if "" == opts.CommitMessage {
+		return fmt.Errorf("Missing commit_message")
+	}
+
+	if "" != opts.StartBranch && "" != opts.StartSHA {
+		return fmt.Errorf("start_branch and start_sha are mutually exclusive")
+	}
+
+	if 0 == len(opts.Actions) {
+		return fmt.Errorf("Missing actions")
+	}
+
+	for _, a := range opts.Actions {
+		if err := a.check(); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Create a commit applying several file changes at once
+func (g *Gitlab) CreateCommit(id string, opts *CreateCommitOpts) (*Commit, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check create commit parameters error: %v", err)
+	}
+
+	body, err := json.Marshal(opts)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodPost,
+		g.ResourceUrl(repo_url_commits, map[string]string{":id": id}),
+		body,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request create commit API error: %v", err)
+	}
+
+	var c *Commit
+	if err := json.Unmarshal(data, &c); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return c, nil
+}
@@ -1,6 +1,10 @@
 package gogitlab
 
 import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -31,3 +35,170 @@ func TestProjectCommitStatuses(t *testing.T) {
 	assert.Equal(t, statuses[1].Sha, "18f3e63d05582537db6d183d9d557be09e1f90c8")
 	assert.Equal(t, statuses[1].TargetURL, "https://gitlab.example.com/thedude/gitlab-ce/builds/90")
 }
+
+func TestCreateCommit(t *testing.T) {
+	var body map[string]interface{}
+	stub, _ := ioutil.ReadFile("stubs/commits/create.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	executable := true
+	script := &CommitAction{Action: CommitActionCreate, FilePath: "bin/run"}
+	script.SetContent([]byte("#!/bin/sh\n"))
+	opts := &CreateCommitOpts{
+		Branch:        "master",
+		CommitMessage: "some commit message",
+		Actions: []*CommitAction{
+			script,
+			{Action: CommitActionChmod, FilePath: "bin/run", ExecuteFilemode: &executable},
+			{Action: CommitActionMove, FilePath: "lib/new.rb", PreviousPath: "lib/old.rb"},
+			{Action: CommitActionDelete, FilePath: "README"},
+		},
+	}
+	commit, err := gitlab.CreateCommit("1", opts)
+
+	assert.NoError(t, err)
+	assert.Equal(t, commit.Id, "ed899a2f4b50b4370feeea94676502b42383c746")
+	actions := body["actions"].([]interface{})
+	assert.Equal(t, len(actions), 4)
+	assert.Equal(t, actions[0].(map[string]interface{})["encoding"], "base64")
+	assert.Equal(t, actions[0].(map[string]interface{})["content"], "IyEvYmluL3NoCg==")
+
+	opts.Actions = append(opts.Actions, &CommitAction{Action: CommitActionMove, FilePath: "lib/new.rb"})
+	_, err = gitlab.CreateCommit("1", opts)
+	assert.Error(t, err)
+
+	_, err = gitlab.CreateCommit("1", &CreateCommitOpts{Branch: "master", CommitMessage: "empty"})
+	assert.Error(t, err)
+}
+
+func TestRepoCommit(t *testing.T) {
+	ts, gitlab := Stub("stubs/commits/show.json")
+	defer ts.Close()
+
+	commit, err := gitlab.RepoCommit("1", "master")
+
+	assert.NoError(t, err)
+	assert.Equal(t, commit.Id, "6104942438c14ec7bd21c6cd5bd995272b3faff6")
+	assert.Equal(t, commit.ParentIds, []string{"ae1d9fb46aa2b07ee9836d49862ec4e2c46fbbba"})
+	assert.Equal(t, commit.Stats.Total, 25)
+	assert.Equal(t, commit.LastPipeline.Id, 8)
+	assert.Equal(t, commit.CreatedAt.Year(), 2012)
+}
+
+func TestRepoCommitDiff(t *testing.T) {
+	ts, gitlab := Stub("stubs/commits/diff.json")
+	defer ts.Close()
+
+	diffs, err := gitlab.RepoCommitDiff("1", "master", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(diffs), 1)
+	assert.Equal(t, diffs[0].NewPath, "doc/update/5.4-to-6.0.md")
+	assert.Equal(t, diffs[0].BMode, "100644")
+}
+
+func TestRepoCommitRefs(t *testing.T) {
+	ts, gitlab := Stub("stubs/commits/refs.json")
+	defer ts.Close()
+
+	refs, err := gitlab.RepoCommitRefs("1", "5937ac0a7beb003549fc5fd26fc247adbce4a52e", CommitRefAll)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(refs), 3)
+	assert.Equal(t, refs[2].Type, CommitRefTag)
+	assert.Equal(t, refs[2].Name, "v1.1.0")
+
+	_, err = gitlab.RepoCommitRefs("1", "5937ac0a7beb003549fc5fd26fc247adbce4a52e", "note")
+	assert.Error(t, err)
+}
+
+func TestRepoCommitComments(t *testing.T) {
+	ts, gitlab := Stub("stubs/commits/comments.json")
+	defer ts.Close()
+
+	comments, err := gitlab.RepoCommitComments("1", "master")
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(comments), 1)
+	assert.Equal(t, comments[0].Author.Username, "admin")
+}
+
+func TestPostCommitComment(t *testing.T) {
+	ts, gitlab := Stub("stubs/commits/comment.json")
+	defer ts.Close()
+
+	comment, err := gitlab.PostCommitComment("1", "18f3e63d05582537db6d183d9d557be09e1f90c8", &CommitCommentOpts{
+		Note:     "Nice picture man!",
+		Path:     "README.md",
+		Line:     11,
+		LineType: "new",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, comment.Line, 11)
+	assert.Equal(t, comment.Path, "README.md")
+
+	_, err = gitlab.PostCommitComment("1", "18f3e63d05582537db6d183d9d557be09e1f90c8", &CommitCommentOpts{Note: "x", Line: 11})
+	assert.Error(t, err)
+}
+
+func TestRepoCommitMergeRequests(t *testing.T) {
+	ts, gitlab := Stub("stubs/commits/merge_requests.json")
+	defer ts.Close()
+
+	mrs, err := gitlab.RepoCommitMergeRequests("1", "af5b13261899fb2c0db30abdd0af8b07cb44fdc5")
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(mrs), 1)
+	assert.Equal(t, mrs[0].SourceBranch, "test-branch")
+}
+
+func TestCherryPickCommit(t *testing.T) {
+	var body map[string]interface{}
+	stub, _ := ioutil.ReadFile("stubs/commits/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		if true == body["dry_run"] {
+			w.Write([]byte(`{"dry_run": "success"}`))
+			return
+		}
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	commit, err := gitlab.CherryPickCommit("1", "6104942438c14ec7bd21c6cd5bd995272b3faff6", "release/1.0", false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, body["branch"], "release/1.0")
+	assert.Equal(t, commit.Id, "6104942438c14ec7bd21c6cd5bd995272b3faff6")
+
+	commit, err = gitlab.CherryPickCommit("1", "6104942438c14ec7bd21c6cd5bd995272b3faff6", "release/1.0", true)
+
+	assert.NoError(t, err)
+	assert.Nil(t, commit)
+
+	_, err = gitlab.CherryPickCommit("1", "6104942438c14ec7bd21c6cd5bd995272b3faff6", "", false)
+	assert.Error(t, err)
+}
+
+func TestRevertCommitConflict(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"message": "Sorry, we cannot revert this commit automatically.", "error_code": "conflict"}`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	commit, err := gitlab.RevertCommit("1", "6104942438c14ec7bd21c6cd5bd995272b3faff6", "master", false)
+
+	assert.Nil(t, commit)
+	assert.True(t, IsCommitConflictErr(err))
+	assert.Equal(t, err.(*CommitPickError).Status, http.StatusBadRequest)
+	assert.Equal(t, err.(*CommitPickError).Message, "Sorry, we cannot revert this commit automatically.")
+}
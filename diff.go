@@ -0,0 +1,315 @@
+package gogitlab
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	DiffLineContext = "context"
+	DiffLineAdded   = "added"
+	DiffLineRemoved = "removed"
+)
+
+// A line of a hunk, OldLine is 0 for an added line and NewLine for a removed one
+type DiffLine struct {
+	Kind    string
+	OldLine int
+	NewLine int
+	Content string // Without the leading +, - or space
+	// Set when the line is the last of the file and has no end of line
+	NoNewline bool
+}
+
+type DiffHunk struct {
+	OldStart int
+	OldLines int
+	NewStart int
+	NewLines int
+	Section  string // The text after the closing @@, usually the enclosing function
+	Lines    []*DiffLine
+}
+
+// The changes of a single file, paths are empty when the diff has no file header
+type DiffFile struct {
+	OldPath     string
+	NewPath     string
+	NewFile     bool
+	RenamedFile bool
+	DeletedFile bool
+	Binary      bool
+	Hunks       []*DiffHunk
+}
+
+var hunkHeaderRe = regexp.MustCompile(`^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@ ?(.*)$`)
+
+func parseHunkHeader(line string) (*DiffHunk, error) {
+	m := hunkHeaderRe.FindStringSubmatch(line)
+	if nil == m {
+		return nil, fmt.Errorf("Invalid hunk header '%s'", line)
+	}
+
+	count := func(s string) int {
+		if "" == s {
+			return 1
+		}
+		n, _ := strconv.Atoi(s)
+		return n
+	}
+
+	h := &DiffHunk{Section: m[5]}
+	h.OldStart, _ = strconv.Atoi(m[1])
+	h.OldLines = count(m[2])
+	h.NewStart, _ = strconv.Atoi(m[3])
+	h.NewLines = count(m[4])
+	return h, nil
+}
+
+// Strip the a/ or b/ prefix and the timestamp of a ---/+++ header path
+func diffHeaderPath(s string) string {
+	if i := strings.IndexByte(s, '\t'); i >= 0 {
+		s = s[:i]
+	}
+	if "/dev/null" == s {
+		return ""
+	}
+	if strings.HasPrefix(s, "a/") || strings.HasPrefix(s, "b/") {
+		return s[2:]
+	}
+	return s
+}
+
+/*
+Parse a unified diff, either a whole git diff or the diff of a single file
+as returned in ChangeItem.Diff, which has no file header.
+
+Usage:
+
+	files, err := gogitlab.ParseDiff(diff)
+	for _, f := range files {
+		for _, h := range f.Hunks {
+			for _, l := range h.Lines {
+				if gogitlab.DiffLineAdded == l.Kind {
+					fmt.Printf("%s:%d %s\n", f.NewPath, l.NewLine, l.Content)
+				}
+			}
+		}
+	}
+
+*/
+func ParseDiff(diff string) ([]*DiffFile, error) {
+	var files []*DiffFile
+	var file *DiffFile
+	var hunk *DiffHunk
+	var oldLeft, newLeft, oldLine, newLine int
+
+	newFile := func() {
+		file = &DiffFile{}
+		files = append(files, file)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(diff, "\n"), "\n")
+	if "" == diff {
+		lines = nil
+	}
+
+	for _, line := range lines {
+		// Inside a hunk until its line counts are reached
+		if oldLeft > 0 || newLeft > 0 {
+			l := &DiffLine{OldLine: oldLine, NewLine: newLine}
+			if "" != line {
+				l.Content = line[1:]
+			}
+
+			switch {
+			case "" == line || ' ' == line[0]:
+				// Some tools strip the space of an empty context line
+				l.Kind = DiffLineContext
+				oldLeft--
+				newLeft--
+				oldLine++
+				newLine++
+			case '-' == line[0]:
+				l.Kind = DiffLineRemoved
+				l.NewLine = 0
+				oldLeft--
+				oldLine++
+			case '+' == line[0]:
+				l.Kind = DiffLineAdded
+				l.OldLine = 0
+				newLeft--
+				newLine++
+			case '\\' == line[0]:
+				if n := len(hunk.Lines); n > 0 {
+					hunk.Lines[n-1].NoNewline = true
+				}
+				continue
+			default:
+				return nil, fmt.Errorf("Invalid hunk line '%s'", line)
+			}
+
+			if oldLeft < 0 || newLeft < 0 {
+				return nil, fmt.Errorf("Hunk line '%s' exceeds its header counts", line)
+			}
+			hunk.Lines = append(hunk.Lines, l)
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "@@ "):
+			h, err := parseHunkHeader(line)
+			if nil != err {
+				return nil, err
+			}
+			if nil == file {
+				newFile()
+			}
+			hunk = h
+			file.Hunks = append(file.Hunks, hunk)
+			oldLeft, newLeft = h.OldLines, h.NewLines
+			oldLine, newLine = h.OldStart, h.NewStart
+		case strings.HasPrefix(line, `\`) && nil != hunk && len(hunk.Lines) > 0:
+			hunk.Lines[len(hunk.Lines)-1].NoNewline = true
+		case strings.HasPrefix(line, "diff --git "):
+			newFile()
+		case strings.HasPrefix(line, "--- "):
+			// A plain unified diff has no diff --git line between files
+			if nil == file || len(file.Hunks) > 0 {
+				newFile()
+			}
+			file.OldPath = diffHeaderPath(line[4:])
+			file.NewFile = "" == file.OldPath
+		case strings.HasPrefix(line, "+++ ") && nil != file:
+			file.NewPath = diffHeaderPath(line[4:])
+			file.DeletedFile = "" == file.NewPath
+		case nil == file:
+			// Anything before the first file, like a commit message
+		case strings.HasPrefix(line, "new file mode"):
+			file.NewFile = true
+		case strings.HasPrefix(line, "deleted file mode"):
+			file.DeletedFile = true
+		case strings.HasPrefix(line, "rename from "):
+			file.OldPath = line[len("rename from "):]
+			file.RenamedFile = true
+		case strings.HasPrefix(line, "rename to "):
+			file.NewPath = line[len("rename to "):]
+			file.RenamedFile = true
+		case strings.HasPrefix(line, "Binary files "):
+			file.Binary = true
+		}
+	}
+
+	if oldLeft > 0 || newLeft > 0 {
+		return nil, fmt.Errorf("Truncated hunk, %d old and %d new lines missing", oldLeft, newLeft)
+	}
+
+	// Complete the paths of the new and deleted files
+	for _, f := range files {
+		if "" == f.OldPath {
+			f.OldPath = f.NewPath
+		}
+		if "" == f.NewPath {
+			f.NewPath = f.OldPath
+		}
+	}
+
+	return files, nil
+}
+
+// Parse the diff of a change, the paths and flags are the ones of the change
+func (c *ChangeItem) ParseDiff() (*DiffFile, error) {
+	files, err := ParseDiff(c.Diff)
+	if nil != err {
+		return nil, err
+	}
+
+	f := &DiffFile{}
+	if len(files) > 0 {
+		f = files[0]
+	}
+	f.OldPath = c.OldPath
+	f.NewPath = c.NewPath
+	f.NewFile = c.NewFile
+	f.RenamedFile = c.RenamedFile
+	f.DeletedFile = c.DeletedFile
+
+	return f, nil
+}
+
+// The added, removed or unchanged line of the new file, nil if not in the diff
+func (f *DiffFile) NewLine(line int) *DiffLine {
+	if line <= 0 {
+		return nil
+	}
+	for _, h := range f.Hunks {
+		for _, l := range h.Lines {
+			if line == l.NewLine {
+				return l
+			}
+		}
+	}
+	return nil
+}
+
+// The removed or unchanged line of the old file, nil if not in the diff
+func (f *DiffFile) OldLine(line int) *DiffLine {
+	if line <= 0 {
+		return nil
+	}
+	for _, h := range f.Hunks {
+		for _, l := range h.Lines {
+			if line == l.OldLine {
+				return l
+			}
+		}
+	}
+	return nil
+}
+
+/*
+The position of a line of the file to start a discussion on it with
+CreateMergeRequestDiscussion, refs are the diff refs of the merge request
+the diff comes from.
+
+Added lines only get a new_line, removed lines only an old_line and
+unchanged lines both, as expected by GitLab.
+*/
+func (f *DiffFile) Position(refs *DiffRefs, l *DiffLine) *NotePosition {
+	p := &NotePosition{
+		PositionType: PositionText,
+		OldPath:      f.OldPath,
+		NewPath:      f.NewPath,
+	}
+	if nil != refs {
+		p.BaseSHA = refs.BaseSHA
+		p.StartSHA = refs.StartSHA
+		p.HeadSHA = refs.HeadSHA
+	}
+	if DiffLineAdded != l.Kind {
+		p.OldLine = l.OldLine
+	}
+	if DiffLineRemoved != l.Kind {
+		p.NewLine = l.NewLine
+	}
+	return p
+}
+
+// The position of a line of the new file, only lines shown in the diff can be commented
+func (f *DiffFile) NewLinePosition(refs *DiffRefs, line int) (*NotePosition, error) {
+	l := f.NewLine(line)
+	if nil == l {
+		return nil, fmt.Errorf("Line %d of '%s' is not in the diff", line, f.NewPath)
+	}
+	return f.Position(refs, l), nil
+}
+
+// The position of a line of the old file, only lines shown in the diff can be commented
+func (f *DiffFile) OldLinePosition(refs *DiffRefs, line int) (*NotePosition, error) {
+	l := f.OldLine(line)
+	if nil == l {
+		return nil, fmt.Errorf("Line %d of '%s' is not in the diff", line, f.OldPath)
+	}
+	return f.Position(refs, l), nil
+}
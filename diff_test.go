@@ -0,0 +1,128 @@
+package gogitlab
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const changeDiff = `@@ -1,4 +1,5 @@ package main
+ import "fmt"
+-var debug = true
++var debug = false
++var verbose = false
+ 
+ func main() {
+@@ -10,2 +11,2 @@ func main() {
+ 	fmt.Println("a")
+-	fmt.Println("b")
+\ No newline at end of file
++	fmt.Println("c")
+\ No newline at end of file
+`
+
+func TestParseDiff(t *testing.T) {
+	files, err := ParseDiff(changeDiff)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(files), 1)
+	assert.Equal(t, files[0].NewPath, "")
+
+	hunks := files[0].Hunks
+	assert.Equal(t, len(hunks), 2)
+	assert.Equal(t, hunks[0].OldStart, 1)
+	assert.Equal(t, hunks[0].NewLines, 5)
+	assert.Equal(t, hunks[0].Section, "package main")
+	assert.Equal(t, len(hunks[0].Lines), 6)
+	assert.Equal(t, *hunks[0].Lines[1], DiffLine{Kind: DiffLineRemoved, OldLine: 2, Content: "var debug = true"})
+	assert.Equal(t, *hunks[0].Lines[3], DiffLine{Kind: DiffLineAdded, NewLine: 3, Content: "var verbose = false"})
+	assert.Equal(t, *hunks[0].Lines[4], DiffLine{Kind: DiffLineContext, OldLine: 3, NewLine: 4})
+
+	assert.Equal(t, len(hunks[1].Lines), 3)
+	assert.Equal(t, hunks[1].Lines[1].NoNewline, true)
+	assert.Equal(t, *hunks[1].Lines[2], DiffLine{Kind: DiffLineAdded, NewLine: 12, Content: "\tfmt.Println(\"c\")", NoNewline: true})
+}
+
+func TestParseDiffGit(t *testing.T) {
+	files, err := ParseDiff(`diff --git a/old.go b/new.go
+similarity index 90%
+rename from old.go
+rename to new.go
+--- a/old.go
++++ b/new.go
+@@ -1 +1 @@
+-package old
++package new
+diff --git a/gone.txt b/gone.txt
+deleted file mode 100644
+--- a/gone.txt
++++ /dev/null
+@@ -1 +0,0 @@
+--- not a header
+diff --git a/logo.png b/logo.png
+new file mode 100644
+Binary files /dev/null and b/logo.png differ
+`)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(files), 3)
+	assert.Equal(t, files[0].OldPath, "old.go")
+	assert.Equal(t, files[0].NewPath, "new.go")
+	assert.Equal(t, files[0].RenamedFile, true)
+	assert.Equal(t, files[1].NewPath, "gone.txt")
+	assert.Equal(t, files[1].DeletedFile, true)
+	assert.Equal(t, files[1].Hunks[0].Lines[0].Content, "-- not a header")
+	assert.Equal(t, files[2].NewFile, true)
+	assert.Equal(t, files[2].Binary, true)
+	assert.Equal(t, len(files[2].Hunks), 0)
+}
+
+func TestParseDiffErrors(t *testing.T) {
+	_, err := ParseDiff("@@ -1,2 +1,2 @@\n a\n")
+	assert.EqualError(t, err, "Truncated hunk, 1 old and 1 new lines missing")
+
+	_, err = ParseDiff("@@ -1 +1 @\n")
+	assert.EqualError(t, err, "Invalid hunk header '@@ -1 +1 @'")
+
+	_, err = ParseDiff("@@ -1 +1 @@\n*a\n")
+	assert.EqualError(t, err, "Invalid hunk line '*a'")
+
+	files, err := ParseDiff("")
+	assert.NoError(t, err)
+	assert.Equal(t, len(files), 0)
+}
+
+func TestDiffFilePosition(t *testing.T) {
+	change := &ChangeItem{OldPath: "main.go", NewPath: "main.go", Diff: changeDiff}
+	refs := &DiffRefs{BaseSHA: "base", StartSHA: "start", HeadSHA: "head"}
+
+	f, err := change.ParseDiff()
+	assert.NoError(t, err)
+	assert.Equal(t, f.NewPath, "main.go")
+
+	p, err := f.NewLinePosition(refs, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, *p, NotePosition{
+		BaseSHA:      "base",
+		StartSHA:     "start",
+		HeadSHA:      "head",
+		PositionType: PositionText,
+		OldPath:      "main.go",
+		NewPath:      "main.go",
+		NewLine:      3,
+	})
+	assert.NoError(t, p.check())
+
+	p, err = f.NewLinePosition(refs, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, p.OldLine, 3)
+	assert.Equal(t, p.NewLine, 4)
+
+	p, err = f.OldLinePosition(refs, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, p.OldLine, 2)
+	assert.Equal(t, p.NewLine, 0)
+
+	_, err = f.NewLinePosition(refs, 8)
+	assert.EqualError(t, err, "Line 8 of 'main.go' is not in the diff")
+}
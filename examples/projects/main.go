@@ -202,18 +202,11 @@ func main() {
 			return
 		}
 
-		var params map[string]string = make(map[string]string)
-		if state != "" {
-			params["state"] = state
-		}
-		if order != "" {
-			params["order_by"] = order
-		}
-		if sort != "" {
-			params["sort"] = sort
-		}
-
-		mrs, err := gitlab.ProjectMergeRequests(id, params)
+		mrs, err := gitlab.ProjectMergeRequests(id, &gogitlab.ListMergeRequestsOpts{
+			State:   state,
+			OrderBy: order,
+			Sort:    sort,
+		})
 		if err != nil {
 			fmt.Println(err.Error())
 			return
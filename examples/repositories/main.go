@@ -90,7 +90,7 @@ func main() {
 		}
 
 		for _, commit := range commits {
-			fmt.Printf("%s > [%s] %s\n", commit.CreatedAt.Format("Mon 02 Jan 15:04"), commit.Author_Name, commit.Title)
+			fmt.Printf("%s > [%s] %s\n", commit.CreatedAt.Format("Mon 02 Jan 15:04"), commit.AuthorName, commit.Title)
 		}
 	}
 }
@@ -107,8 +107,7 @@ func (g *Gitlab) execRequest(method, url string, body []byte) (*http.Response, e
 		req, err = http.NewRequest(method, url, nil)
 	}
 
-	req.Header.Add("PRIVATE-TOKEN", g.Token)
-	if method == "POST" || method == "PUT" {
+	if method == "POST" || method == "PUT" || method == "PATCH" {
 		req.Header.Add("Content-Type", "application/json")
 	}
 
@@ -116,6 +115,13 @@ func (g *Gitlab) execRequest(method, url string, body []byte) (*http.Response, e
 		panic("Error while building gitlab request")
 	}
 
+	return g.doRequest(req)
+}
+
+// Send an authenticated request, statuses >= 400 are returned as a *respErr
+func (g *Gitlab) doRequest(req *http.Request) (*http.Response, error) {
+	req.Header.Set("PRIVATE-TOKEN", g.Token)
+
 	resp, err := g.Client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("Client.Do error: %q", err)
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"net/http"
 	"fmt"
+	"sort"
 )
 
 const (
@@ -166,6 +167,65 @@ func (g *Gitlab) GroupProjects(id string) ([]*Project, error) {
 	return projects, err
 }
 
+// Storage usage of the projects of a group, see GroupStorageUsage
+type GroupStorage struct {
+	// Sum of the statistics of every project
+	Total ProjectStatistics
+	// Projects with their statistics, the largest storage first
+	Projects []*Project
+}
+
+/*
+Get the storage usage of the projects in this group and its subgroups,
+ranked by storage size.
+
+The projects are listed with their statistics, 100 per page, until a
+short page comes back.
+*/
+func (g *Gitlab) GroupStorageUsage(id string) (*GroupStorage, error) {
+	usage := &GroupStorage{}
+	for page := (Pagination{Page: 1, PerPage: 100}); ; page.Page++ {
+		query, err := paginationQuery(&page)
+		if nil != err {
+			return nil, fmt.Errorf("Check list group projects parameters error: %v", err)
+		}
+		query["statistics"] = "true"
+		query["include_subgroups"] = "true"
+
+		data, err := g.buildAndExecRequest(
+			http.MethodGet,
+			g.ResourceUrlWithQuery(group_projects_url, map[string]string{":id": id}, query),
+			nil,
+		)
+		if nil != err {
+			return nil, fmt.Errorf("Request list group projects API error: %v", err)
+		}
+
+		var projects []*Project
+		if err := json.Unmarshal(data, &projects); nil != err {
+			return nil, fmt.Errorf("Decode response error: %v", err)
+		}
+
+		for _, p := range projects {
+			if nil == p.Statistics {
+				p.Statistics = &ProjectStatistics{}
+			}
+			usage.Total.add(p.Statistics)
+			usage.Projects = append(usage.Projects, p)
+		}
+
+		if len(projects) < page.PerPage {
+			break
+		}
+	}
+
+	sort.SliceStable(usage.Projects, func(i, j int) bool {
+		return usage.Projects[i].Statistics.StorageSize > usage.Projects[j].Statistics.StorageSize
+	})
+
+	return usage, nil
+}
+
 /*
 Gets a list of group or project members viewable by the authenticated user
 */
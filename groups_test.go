@@ -1,6 +1,10 @@
 package gogitlab
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -106,6 +110,41 @@ func TestGroupProjects(t *testing.T) {
 	assert.Equal(t, projects[1].Owner.Name, "Brightbox")
 }
 
+func TestGroupStorageUsage(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/groups/1/projects")
+		q := r.URL.Query()
+		assert.Equal(t, q.Get("statistics"), "true")
+		assert.Equal(t, q.Get("include_subgroups"), "true")
+		assert.Equal(t, q.Get("per_page"), "100")
+
+		// A full first page of 100 projects, then a short one of 2
+		count, first := 100, 1
+		if "2" == q.Get("page") {
+			count, first = 2, 101
+		}
+		projects := make([]string, count)
+		for i := range projects {
+			id := first + i
+			projects[i] = fmt.Sprintf(`{"id": %d, "statistics": {"commit_count": 1, "storage_size": %d, "lfs_objects_size": 2}}`, id, id*10)
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(projects, ","))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	usage, err := gitlab.GroupStorageUsage("1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(usage.Projects), 102)
+	assert.Equal(t, usage.Projects[0].Id, 102)
+	assert.Equal(t, usage.Projects[1].Id, 101)
+	assert.Equal(t, usage.Projects[101].Id, 1)
+	assert.Equal(t, usage.Total.CommitCount, int64(102))
+	assert.Equal(t, usage.Total.StorageSize, int64(102*103/2*10))
+	assert.Equal(t, usage.Total.LfsObjectsSize, int64(204))
+}
+
 func TestGroupMembers(t *testing.T) {
 	ts, gitlab := Stub("stubs/groups/members/index.json")
 	defer ts.Close()
@@ -2,10 +2,25 @@ package gogitlab
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 const (
-	project_issues_url = "/projects/:id/issues"
+	issues_url             = "/issues"                             // Get all issues the authenticated user has access to
+	group_issues_url       = "/groups/:id/issues"                  // Get group issues
+	project_issues_url     = "/projects/:id/issues"                // Get or create project issues
+	project_issue_url      = "/projects/:id/issues/:issue_id"      // Get, edit or delete a single issue
+	project_issue_move_url = "/projects/:id/issues/:issue_id/move" // Move an issue to another project
+)
+
+const (
+	project_issue_related_merge_requests_url = "/projects/:id/issues/:issue_id/related_merge_requests" // List the merge requests mentioning an issue
+	project_issue_closed_by_url              = "/projects/:id/issues/:issue_id/closed_by"              // List the merge requests closing an issue on merge
 )
 
 type Milestone struct {
@@ -33,14 +48,65 @@ type Issue struct {
 	State       string     `json:"state,omitempty"`
 	CreatedAt   string     `json:"created_at,omitempty"`
 	UpdatedAt   string     `json:"updated_at,omitempty"`
+
+	// Details of recent GitLab versions
+	Assignees      []*User    `json:"assignees,omitempty"`
+	ClosedAt       string     `json:"closed_at,omitempty"`
+	ClosedBy       *User      `json:"closed_by,omitempty"`
+	DueDate        string     `json:"due_date,omitempty"`
+	Confidential   bool       `json:"confidential,omitempty"`
+	Weight         *int       `json:"weight,omitempty"`
+	UserNotesCount int        `json:"user_notes_count,omitempty"`
+	WebUrl         string     `json:"web_url,omitempty"`
+	TimeStats      *TimeStats `json:"time_stats,omitempty"`
 }
 
+// Parameters of an issue, empty fields are left unchanged on edit
 type IssueRequest struct {
-	Title       string `json:"title"`
+	Title       string `json:"title,omitempty"`
 	Description string `json:"description,omitempty"`
 	AssigneeId  int    `json:"assignee_id,omitempty"`
 	MilestoneId int    `json:"milestone_id,omitempty"`
 	Labels      string `json:"labels,omitempty"`
+
+	// Replaces AssigneeId, point to an empty slice to unassign everyone on edit
+	AssigneeIds *[]int `json:"assignee_ids,omitempty"`
+	// Formatted as YYYY-MM-DD
+	DueDate      string `json:"due_date,omitempty"`
+	Confidential *bool  `json:"confidential,omitempty"`
+	Weight       *int   `json:"weight,omitempty"`
+	// Edit only, either close or reopen
+	StateEvent string `json:"state_event,omitempty"`
+}
+
+func (req *IssueRequest) check(create bool) error {
+	if nil == req {
+		return fmt.Errorf("Missing issue parameters")
+	}
+
+	if create && "" == req.Title {
+		return fmt.Errorf("Missing title")
+	}
+
+	if create && "" != req.StateEvent {
+		return fmt.Errorf("Invalid state_event '%s' on creation", req.StateEvent)
+	}
+
+	if "" != req.StateEvent && "close" != req.StateEvent && "reopen" != req.StateEvent {
+		return fmt.Errorf("Invalid state_event '%s'", req.StateEvent)
+	}
+
+	if "" != req.DueDate {
+		if _, err := time.Parse("2006-01-02", req.DueDate); nil != err {
+			return fmt.Errorf("Invalid due_date '%s'", req.DueDate)
+		}
+	}
+
+	if nil != req.Weight && *req.Weight < 0 {
+		return fmt.Errorf("Invalid weight '%d'", *req.Weight)
+	}
+
+	return nil
 }
 
 func (g *Gitlab) AddIssue(projectId string, req *IssueRequest) (issue *Issue, err error) {
@@ -49,6 +115,11 @@ func (g *Gitlab) AddIssue(projectId string, req *IssueRequest) (issue *Issue, er
 	}
 	u := g.ResourceUrl(project_issues_url, params)
 
+	if err = req.check(true); err != nil {
+		err = fmt.Errorf("Check add issue parameters error: %v", err)
+		return
+	}
+
 	encodedRequest, err := json.Marshal(req)
 	if err != nil {
 		return
@@ -66,3 +137,373 @@ func (g *Gitlab) AddIssue(projectId string, req *IssueRequest) (issue *Issue, er
 	}
 	return
 }
+
+type ListIssuesOpts struct {
+	// One of opened, closed or all
+	State string
+	// One of created_at, updated_at, priority, due_date, relative_position,
+	// label_priority, milestone_due, popularity or weight
+	OrderBy string
+	// Either asc or desc, desc by default
+	Sort string
+	// Milestone title, None or Any are also accepted
+	Milestone string
+	// Only the issues having all these labels
+	Labels     []string
+	AuthorId   int
+	AssigneeId int
+	Search     string
+	// Only the confidential issues when true, only the public ones when false
+	Confidential  *bool
+	Iids          []int
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+	// One of created_by_me, assigned_to_me or all
+	Scope string
+	Pagination
+}
+
+var (
+	validIssueState = map[string]bool{
+		"opened": true,
+		"closed": true,
+		"all":    true,
+	}
+
+	validIssueOrder = map[string]bool{
+		"created_at":        true,
+		"updated_at":        true,
+		"priority":          true,
+		"due_date":          true,
+		"relative_position": true,
+		"label_priority":    true,
+		"milestone_due":     true,
+		"popularity":        true,
+		"weight":            true,
+	}
+
+	validIssueScope = map[string]bool{
+		"created_by_me":  true,
+		"assigned_to_me": true,
+		"all":            true,
+	}
+)
+
+func (opts *ListIssuesOpts) check() error {
+	if "" != opts.State && !validIssueState[opts.State] {
+		return fmt.Errorf("Invalid state '%s'", opts.State)
+	}
+
+	if "" != opts.OrderBy && !validIssueOrder[opts.OrderBy] {
+		return fmt.Errorf("Invalid order_by '%s'", opts.OrderBy)
+	}
+
+	if "" != opts.Sort && !validSort[opts.Sort] {
+		return fmt.Errorf("Invalid sort '%s'", opts.Sort)
+	}
+
+	if "" != opts.Scope && !validIssueScope[opts.Scope] {
+		return fmt.Errorf("Invalid scope '%s'", opts.Scope)
+	}
+
+	if nil != opts.CreatedAfter && nil != opts.CreatedBefore && opts.CreatedBefore.Before(*opts.CreatedAfter) {
+		return fmt.Errorf("Invalid created_before '%s' before created_after '%s'", opts.CreatedBefore.Format(time.RFC3339), opts.CreatedAfter.Format(time.RFC3339))
+	}
+
+	if nil != opts.UpdatedAfter && nil != opts.UpdatedBefore && opts.UpdatedBefore.Before(*opts.UpdatedAfter) {
+		return fmt.Errorf("Invalid updated_before '%s' before updated_after '%s'", opts.UpdatedBefore.Format(time.RFC3339), opts.UpdatedAfter.Format(time.RFC3339))
+	}
+
+	return opts.Pagination.check()
+}
+
+func (opts *ListIssuesOpts) toQueryValues() (url.Values, error) {
+	if nil == opts {
+		return nil, nil
+	}
+
+	if err := opts.check(); nil != err {
+		return nil, err
+	}
+
+	query := make(url.Values)
+	if "" != opts.State {
+		query.Set("state", opts.State)
+	}
+	if "" != opts.OrderBy {
+		query.Set("order_by", opts.OrderBy)
+	}
+	if "" != opts.Sort {
+		query.Set("sort", opts.Sort)
+	}
+	if "" != opts.Milestone {
+		query.Set("milestone", opts.Milestone)
+	}
+	if len(opts.Labels) > 0 {
+		query.Set("labels", strings.Join(opts.Labels, ","))
+	}
+	if opts.AuthorId > 0 {
+		query.Set("author_id", strconv.Itoa(opts.AuthorId))
+	}
+	if opts.AssigneeId > 0 {
+		query.Set("assignee_id", strconv.Itoa(opts.AssigneeId))
+	}
+	if "" != opts.Search {
+		query.Set("search", opts.Search)
+	}
+	if nil != opts.Confidential {
+		query.Set("confidential", strconv.FormatBool(*opts.Confidential))
+	}
+	for _, iid := range opts.Iids {
+		query.Add("iids[]", strconv.Itoa(iid))
+	}
+	if nil != opts.CreatedAfter {
+		query.Set("created_after", opts.CreatedAfter.Format(time.RFC3339))
+	}
+	if nil != opts.CreatedBefore {
+		query.Set("created_before", opts.CreatedBefore.Format(time.RFC3339))
+	}
+	if nil != opts.UpdatedAfter {
+		query.Set("updated_after", opts.UpdatedAfter.Format(time.RFC3339))
+	}
+	if nil != opts.UpdatedBefore {
+		query.Set("updated_before", opts.UpdatedBefore.Format(time.RFC3339))
+	}
+	if "" != opts.Scope {
+		query.Set("scope", opts.Scope)
+	}
+	opts.Pagination.toQueryValues(query)
+	return query, nil
+}
+
+func (g *Gitlab) listIssues(u string, params map[string]string, opts *ListIssuesOpts) ([]*Issue, error) {
+	query, err := opts.toQueryValues()
+	if nil != err {
+		return nil, fmt.Errorf("Check list issues parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQueryValues(u, params, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list issues API error: %v", err)
+	}
+
+	var issues []*Issue
+	if err := json.Unmarshal(data, &issues); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return issues, nil
+}
+
+func issueParams(projectId string, issueIid int) map[string]string {
+	return map[string]string{":id": projectId, ":issue_id": strconv.Itoa(issueIid)}
+}
+
+func (g *Gitlab) execIssue(method, u string, params map[string]string, body []byte) (*Issue, error) {
+	data, err := g.buildAndExecRequest(method, g.ResourceUrl(u, params), body)
+	if nil != err {
+		return nil, fmt.Errorf("Request issue API error: %v", err)
+	}
+
+	var issue *Issue
+	if err := json.Unmarshal(data, &issue); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return issue, nil
+}
+
+/*
+Get list of project issues.
+
+    GET /projects/:id/issues
+
+Parameters:
+
+    projectId The ID of a project
+    opts      The filters of the listing, nil to list every issue
+
+Usage:
+
+	issues, err := gitlab.ListProjectIssues("your_projet_id", &ListIssuesOpts{
+		State:  "opened",
+		Labels: []string{"bug"},
+		Iids:   []int{4, 8},
+	})
+
+*/
+func (g *Gitlab) ListProjectIssues(projectId string, opts *ListIssuesOpts) ([]*Issue, error) {
+	return g.listIssues(project_issues_url, map[string]string{":id": projectId}, opts)
+}
+
+/*
+Get list of group issues, including the ones of its subgroups.
+
+    GET /groups/:id/issues
+
+Parameters:
+
+    groupId The ID of a group
+    opts    The filters of the listing, see ListProjectIssues
+
+*/
+func (g *Gitlab) ListGroupIssues(groupId string, opts *ListIssuesOpts) ([]*Issue, error) {
+	return g.listIssues(group_issues_url, map[string]string{":id": groupId}, opts)
+}
+
+/*
+Get list of the issues the authenticated user has access to, only the
+ones created by the user unless opts.Scope is set.
+
+    GET /issues
+
+*/
+func (g *Gitlab) ListIssues(opts *ListIssuesOpts) ([]*Issue, error) {
+	return g.listIssues(issues_url, nil, opts)
+}
+
+/*
+Get a single project issue.
+
+    GET /projects/:id/issues/:issue_iid
+
+Parameters:
+
+    projectId The ID of a project
+    issueIid  The IID of an issue
+
+*/
+func (g *Gitlab) ProjectIssue(projectId string, issueIid int) (*Issue, error) {
+	return g.execIssue(http.MethodGet, project_issue_url, issueParams(projectId, issueIid), nil)
+}
+
+/*
+Update an issue, only the fields set in req are changed.
+
+    PUT /projects/:id/issues/:issue_iid
+
+Parameters:
+
+    projectId The ID of a project
+    issueIid  The IID of an issue
+    req       The changes, StateEvent closes or reopens the issue
+
+*/
+func (g *Gitlab) EditIssue(projectId string, issueIid int, req *IssueRequest) (*Issue, error) {
+	if err := req.check(false); nil != err {
+		return nil, fmt.Errorf("Check edit issue parameters error: %v", err)
+	}
+
+	body, err := json.Marshal(req)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	return g.execIssue(http.MethodPut, project_issue_url, issueParams(projectId, issueIid), body)
+}
+
+func (g *Gitlab) CloseIssue(projectId string, issueIid int) (*Issue, error) {
+	return g.EditIssue(projectId, issueIid, &IssueRequest{StateEvent: "close"})
+}
+
+func (g *Gitlab) ReopenIssue(projectId string, issueIid int) (*Issue, error) {
+	return g.EditIssue(projectId, issueIid, &IssueRequest{StateEvent: "reopen"})
+}
+
+/*
+Move an issue to another project, the returned issue is the new one.
+
+    POST /projects/:id/issues/:issue_iid/move
+
+Parameters:
+
+    projectId   The ID of a project
+    issueIid    The IID of an issue
+    toProjectId The ID of the destination project
+
+*/
+func (g *Gitlab) MoveIssue(projectId string, issueIid, toProjectId int) (*Issue, error) {
+	body, err := json.Marshal(map[string]int{"to_project_id": toProjectId})
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	return g.execIssue(http.MethodPost, project_issue_move_url, issueParams(projectId, issueIid), body)
+}
+
+// Delete an issue, only allowed to administrators and project owners
+func (g *Gitlab) DeleteIssue(projectId string, issueIid int) error {
+	_, err := g.buildAndExecRequest(
+		http.MethodDelete,
+		g.ResourceUrl(project_issue_url, issueParams(projectId, issueIid)),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request delete issue API error: %v", err)
+	}
+
+	return err
+}
+
+func (g *Gitlab) listIssueMergeRequests(u, projectId string, issueIid int) ([]*MergeRequest, error) {
+	data, err := g.buildAndExecRequest(http.MethodGet, g.ResourceUrl(u, issueParams(projectId, issueIid)), nil)
+	if nil != err {
+		return nil, fmt.Errorf("Request list issue merge requests API error: %v", err)
+	}
+
+	var mrs []*MergeRequest
+	if err := json.Unmarshal(data, &mrs); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return mrs, nil
+}
+
+/*
+Get the merge requests related to an issue, the ones mentioning it in
+their description, commits or notes.
+
+    GET /projects/:id/issues/:issue_iid/related_merge_requests
+
+Parameters:
+
+    projectId The ID of a project
+    issueIid  The IID of an issue
+
+*/
+func (g *Gitlab) IssueRelatedMergeRequests(projectId string, issueIid int) ([]*MergeRequest, error) {
+	return g.listIssueMergeRequests(project_issue_related_merge_requests_url, projectId, issueIid)
+}
+
+// Get the merge requests that close the issue once merged
+func (g *Gitlab) IssueClosedBy(projectId string, issueIid int) ([]*MergeRequest, error) {
+	return g.listIssueMergeRequests(project_issue_closed_by_url, projectId, issueIid)
+}
+
+// Set the estimated time of an issue, duration is like 3h30m
+func (g *Gitlab) SetIssueTimeEstimate(projectId string, issueIid int, duration string) (*TimeStats, error) {
+	return g.setTimeEstimate(project_issue_url, issueParams(projectId, issueIid), duration)
+}
+
+func (g *Gitlab) ResetIssueTimeEstimate(projectId string, issueIid int) (*TimeStats, error) {
+	return g.execTimeStats(http.MethodPost, project_issue_url, "reset_time_estimate", issueParams(projectId, issueIid), nil)
+}
+
+// Add time spent on an issue, a negative duration like -1h removes time
+func (g *Gitlab) AddIssueSpentTime(projectId string, issueIid int, duration, summary string) (*TimeStats, error) {
+	return g.addSpentTime(project_issue_url, issueParams(projectId, issueIid), duration, summary)
+}
+
+func (g *Gitlab) ResetIssueSpentTime(projectId string, issueIid int) (*TimeStats, error) {
+	return g.execTimeStats(http.MethodPost, project_issue_url, "reset_spent_time", issueParams(projectId, issueIid), nil)
+}
+
+func (g *Gitlab) IssueTimeStats(projectId string, issueIid int) (*TimeStats, error) {
+	return g.execTimeStats(http.MethodGet, project_issue_url, "time_stats", issueParams(projectId, issueIid), nil)
+}
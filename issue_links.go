@@ -0,0 +1,124 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+)
+
+var (
+	issueLinksUrl = path.Join(project_issue_url, "links")
+	issueLinkUrl  = path.Join(project_issue_url, "links", ":issue_link_id")
+)
+
+const (
+	IssueLinkRelatesTo   = "relates_to"
+	IssueLinkBlocks      = "blocks"
+	IssueLinkIsBlockedBy = "is_blocked_by"
+)
+
+// An issue linked to another one, as listed by IssueLinks
+type LinkedIssue struct {
+	Issue
+	IssueLinkId   int        `json:"issue_link_id"`
+	LinkType      string     `json:"link_type"`
+	LinkCreatedAt *time.Time `json:"link_created_at"`
+	LinkUpdatedAt *time.Time `json:"link_updated_at"`
+}
+
+type IssueLink struct {
+	SourceIssue *Issue `json:"source_issue"`
+	TargetIssue *Issue `json:"target_issue"`
+	LinkType    string `json:"link_type"`
+}
+
+type IssueLinkOpts struct {
+	// The ID or path of the project of the target issue
+	TargetProjectId string `json:"target_project_id"`
+	TargetIssueIid  int    `json:"target_issue_iid"`
+	// One of relates_to, blocks or is_blocked_by, relates_to by default
+	LinkType string `json:"link_type,omitempty"`
+}
+
+func (opts *IssueLinkOpts) check() error {
+	if nil == opts || "" == opts.TargetProjectId || opts.TargetIssueIid <= 0 {
+		return fmt.Errorf("Missing target_project_id or target_issue_iid")
+	}
+
+	switch opts.LinkType {
+	case "", IssueLinkRelatesTo, IssueLinkBlocks, IssueLinkIsBlockedBy:
+		return nil
+	}
+
+	return fmt.Errorf("Invalid link_type '%s'", opts.LinkType)
+}
+
+func (g *Gitlab) execIssueLink(method, u string, params map[string]string, body []byte) (*IssueLink, error) {
+	data, err := g.buildAndExecRequest(method, g.ResourceUrl(u, params), body)
+	if nil != err {
+		return nil, fmt.Errorf("Request issue link API error: %v", err)
+	}
+
+	var l *IssueLink
+	if err := json.Unmarshal(data, &l); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return l, nil
+}
+
+// List the issues linked to an issue, with the type and the ID of each link
+func (g *Gitlab) IssueLinks(projectId string, issueIid int) ([]*LinkedIssue, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrl(issueLinksUrl, issueParams(projectId, issueIid)),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list issue links API error: %v", err)
+	}
+
+	var issues []*LinkedIssue
+	if err := json.Unmarshal(data, &issues); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return issues, nil
+}
+
+/*
+Link an issue to another one, possibly of another project.
+
+    POST /projects/:id/issues/:issue_iid/links
+
+Usage:
+
+	link, err := gitlab.CreateIssueLink("your_projet_id", 14, &IssueLinkOpts{
+		TargetProjectId: "your_projet_id",
+		TargetIssueIid:  8,
+		LinkType:        IssueLinkBlocks,
+	})
+
+*/
+func (g *Gitlab) CreateIssueLink(projectId string, issueIid int, opts *IssueLinkOpts) (*IssueLink, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check create issue link parameters error: %v", err)
+	}
+
+	body, err := json.Marshal(opts)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	return g.execIssueLink(http.MethodPost, issueLinksUrl, issueParams(projectId, issueIid), body)
+}
+
+// Remove a link, linkId is the IssueLinkId listed by IssueLinks
+func (g *Gitlab) DeleteIssueLink(projectId string, issueIid, linkId int) (*IssueLink, error) {
+	params := issueParams(projectId, issueIid)
+	params[":issue_link_id"] = strconv.Itoa(linkId)
+	return g.execIssueLink(http.MethodDelete, issueLinkUrl, params, nil)
+}
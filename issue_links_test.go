@@ -0,0 +1,70 @@
+package gogitlab
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueLinks(t *testing.T) {
+	ts, gitlab := Stub("stubs/projects/issues/links.json")
+	defer ts.Close()
+
+	issues, err := gitlab.IssueLinks("4", 11)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(issues), 2)
+	assert.Equal(t, issues[0].IId, 14)
+	assert.Equal(t, issues[0].IssueLinkId, 1)
+	assert.Equal(t, issues[1].LinkType, IssueLinkIsBlockedBy)
+	assert.Equal(t, issues[1].Author.Username, "eileen.lowe")
+}
+
+func TestCreateIssueLink(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/projects/issues/link.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/4/issues/11/links")
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, string(body), `{"target_project_id": "4", "target_issue_iid": 14, "link_type": "blocks"}`)
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	link, err := gitlab.CreateIssueLink("4", 11, &IssueLinkOpts{
+		TargetProjectId: "4",
+		TargetIssueIid:  14,
+		LinkType:        IssueLinkBlocks,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, link.SourceIssue.IId, 11)
+	assert.Equal(t, link.TargetIssue.IId, 14)
+	assert.Equal(t, link.LinkType, IssueLinkBlocks)
+
+	_, err = gitlab.CreateIssueLink("4", 11, &IssueLinkOpts{TargetProjectId: "4", TargetIssueIid: 14, LinkType: "duplicates"})
+	assert.EqualError(t, err, "Check create issue link parameters error: Invalid link_type 'duplicates'")
+
+	_, err = gitlab.CreateIssueLink("4", 11, &IssueLinkOpts{TargetIssueIid: 14})
+	assert.EqualError(t, err, "Check create issue link parameters error: Missing target_project_id or target_issue_iid")
+}
+
+func TestDeleteIssueLink(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/projects/issues/link.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodDelete)
+		assert.Equal(t, r.URL.Path, "/projects/4/issues/11/links/1")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	link, err := gitlab.DeleteIssueLink("4", 11, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, link.TargetIssue.IId, 14)
+}
@@ -0,0 +1,51 @@
+package gogitlab
+
+import (
+	"net/http"
+	"path"
+	"strconv"
+)
+
+var (
+	issueNotesUrl = path.Join(project_issue_url, "notes")
+	issueNoteUrl  = path.Join(project_issue_url, "notes", ":note_id")
+)
+
+func issueNoteParams(projectId string, issueIid, noteId int) map[string]string {
+	params := issueParams(projectId, issueIid)
+	params[":note_id"] = strconv.Itoa(noteId)
+	return params
+}
+
+/*
+Get the notes of an issue, including the system notes.
+
+    GET /projects/:id/issues/:issue_iid/notes
+
+Parameters:
+
+    projectId The ID of a project
+    issueIid  The IID of an issue
+    opts      The order of the listing, may be nil
+
+*/
+func (g *Gitlab) IssueNotes(projectId string, issueIid int, opts *ListNotesOpts) ([]*Note, error) {
+	return g.listNotes(issueNotesUrl, issueParams(projectId, issueIid), opts)
+}
+
+func (g *Gitlab) IssueNote(projectId string, issueIid, noteId int) (*Note, error) {
+	return g.execNote(http.MethodGet, issueNoteUrl, issueNoteParams(projectId, issueIid, noteId), nil)
+}
+
+// Comment on an issue
+func (g *Gitlab) CreateIssueNote(projectId string, issueIid int, opts *NoteOpts) (*Note, error) {
+	return g.execNote(http.MethodPost, issueNotesUrl, issueParams(projectId, issueIid), opts)
+}
+
+func (g *Gitlab) UpdateIssueNote(projectId string, issueIid, noteId int, opts *NoteOpts) (*Note, error) {
+	return g.execNote(http.MethodPut, issueNoteUrl, issueNoteParams(projectId, issueIid, noteId), opts)
+}
+
+func (g *Gitlab) DeleteIssueNote(projectId string, issueIid, noteId int) error {
+	return g.deleteNote(issueNoteUrl, issueNoteParams(projectId, issueIid, noteId))
+}
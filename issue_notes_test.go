@@ -0,0 +1,60 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueNotes(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/notes/index.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/projects/4/issues/14/notes")
+		assert.Equal(t, r.URL.Query().Get("order_by"), "updated_at")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	notes, err := gitlab.IssueNotes("4", 14, &ListNotesOpts{OrderBy: "updated_at"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(notes), 2)
+}
+
+func TestCreateIssueNote(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/notes/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/4/issues/14/notes")
+		var opts NoteOpts
+		json.NewDecoder(r.Body).Decode(&opts)
+		assert.Equal(t, opts.Body, "Duplicate of #8")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	note, err := gitlab.CreateIssueNote("4", 14, &NoteOpts{Body: "Duplicate of #8"})
+	assert.NoError(t, err)
+	assert.NotNil(t, note)
+
+	_, err = gitlab.CreateIssueNote("4", 14, &NoteOpts{})
+	assert.EqualError(t, err, "Check note parameters error: Missing body")
+}
+
+func TestDeleteIssueNote(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodDelete)
+		assert.Equal(t, r.URL.Path, "/projects/4/issues/14/notes/302")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	assert.NoError(t, gitlab.DeleteIssueNote("4", 14, 302))
+}
@@ -1,7 +1,11 @@
 package gogitlab
 
 import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -29,3 +33,210 @@ func TestAddIssue(t *testing.T) {
 	assert.Equal(t, issue.CreatedAt, "2014-07-13T19:00:00.000Z")
 	assert.Equal(t, issue.UpdatedAt, issue.CreatedAt)
 }
+
+func TestAddIssueCheck(t *testing.T) {
+	gitlab := NewGitlab("http://127.0.0.1", "", "")
+
+	_, err := gitlab.AddIssue("1", &IssueRequest{})
+	assert.EqualError(t, err, "Check add issue parameters error: Missing title")
+
+	_, err = gitlab.AddIssue("1", &IssueRequest{Title: "Test Issue", DueDate: "22/07/2016"})
+	assert.EqualError(t, err, "Check add issue parameters error: Invalid due_date '22/07/2016'")
+}
+
+func TestListProjectIssues(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/projects/issues/index.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/projects/4/issues")
+		q := r.URL.Query()
+		assert.Equal(t, q.Get("state"), "opened")
+		assert.Equal(t, q.Get("labels"), "bug,critical")
+		assert.Equal(t, q.Get("confidential"), "false")
+		assert.Equal(t, q["iids[]"], []string{"14", "15"})
+		assert.Equal(t, q.Get("created_after"), "2016-01-01T00:00:00Z")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	confidential := false
+	after := time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)
+	issues, err := gitlab.ListProjectIssues("4", &ListIssuesOpts{
+		State:        "opened",
+		Labels:       []string{"bug", "critical"},
+		Confidential: &confidential,
+		Iids:         []int{14, 15},
+		CreatedAfter: &after,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(issues), 2)
+	assert.Equal(t, issues[1].IId, 15)
+	assert.Equal(t, issues[1].Labels, []string{"bug", "critical"})
+
+	_, err = gitlab.ListProjectIssues("4", &ListIssuesOpts{State: "merged"})
+	assert.EqualError(t, err, "Check list issues parameters error: Invalid state 'merged'")
+}
+
+func TestListGroupIssues(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/groups/2/issues")
+		assert.Equal(t, r.URL.Query().Get("order_by"), "due_date")
+		w.Write([]byte(`[]`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	issues, err := gitlab.ListGroupIssues("2", &ListIssuesOpts{OrderBy: "due_date"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(issues), 0)
+}
+
+func TestProjectIssue(t *testing.T) {
+	ts, gitlab := Stub("stubs/projects/issues/show.json")
+	defer ts.Close()
+
+	issue, err := gitlab.ProjectIssue("4", 14)
+
+	assert.NoError(t, err)
+	assert.Equal(t, issue.State, "closed")
+	assert.Equal(t, issue.ClosedBy.Username, "root")
+	assert.Equal(t, len(issue.Assignees), 2)
+	assert.Equal(t, issue.DueDate, "2016-07-22")
+	assert.Equal(t, issue.Confidential, true)
+	assert.Equal(t, *issue.Weight, 3)
+	assert.Equal(t, issue.TimeStats.TotalTimeSpent, 1800)
+}
+
+func TestEditIssue(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPut)
+		assert.Equal(t, r.URL.Path, "/projects/4/issues/14")
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, string(body), `{"assignee_ids": [2, 3], "confidential": true, "weight": 0, "state_event": "close"}`)
+		w.Write([]byte(`{"iid": 14, "state": "closed"}`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	confidential, weight := true, 0
+	issue, err := gitlab.EditIssue("4", 14, &IssueRequest{
+		AssigneeIds:  &[]int{2, 3},
+		Confidential: &confidential,
+		Weight:       &weight,
+		StateEvent:   "close",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, issue.State, "closed")
+
+	_, err = gitlab.EditIssue("4", 14, &IssueRequest{StateEvent: "merge"})
+	assert.EqualError(t, err, "Check edit issue parameters error: Invalid state_event 'merge'")
+}
+
+func TestEditIssueUnassign(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, string(body), `{"assignee_ids":[]}`)
+		w.Write([]byte(`{"iid": 14, "assignees": []}`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	issue, err := gitlab.EditIssue("4", 14, &IssueRequest{AssigneeIds: &[]int{}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(issue.Assignees), 0)
+}
+
+func TestMoveIssue(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/4/issues/14/move")
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, string(body), `{"to_project_id":5}`)
+		w.Write([]byte(`{"id": 92, "iid": 1, "project_id": 5}`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	issue, err := gitlab.MoveIssue("4", 14, 5)
+
+	assert.NoError(t, err)
+	assert.Equal(t, issue.ProjectId, 5)
+	assert.Equal(t, issue.IId, 1)
+}
+
+func TestDeleteIssue(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodDelete)
+		assert.Equal(t, r.URL.Path, "/projects/4/issues/14")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	assert.NoError(t, gitlab.DeleteIssue("4", 14))
+}
+
+func TestIssueClosedBy(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/merge_requests/index.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/projects/4/issues/14/closed_by")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	mrs, err := gitlab.IssueClosedBy("4", 14)
+
+	assert.NoError(t, err)
+	assert.NotEqual(t, len(mrs), 0)
+}
+
+func TestIssueRelatedMergeRequests(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/projects/4/issues/14/related_merge_requests")
+		w.Write([]byte(`[{"iid": 3, "state": "opened"}]`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	mrs, err := gitlab.IssueRelatedMergeRequests("4", 14)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(mrs), 1)
+	assert.Equal(t, mrs[0].Iid, 3)
+}
+
+func TestSetIssueTimeEstimate(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/4/issues/14/time_estimate")
+		assert.Equal(t, r.URL.Query().Get("duration"), "3h30m")
+		w.Write([]byte(`{"time_estimate": 12600, "total_time_spent": 0, "human_time_estimate": "3h 30m", "human_total_time_spent": null}`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	stats, err := gitlab.SetIssueTimeEstimate("4", 14, "3h30m")
+
+	assert.NoError(t, err)
+	assert.Equal(t, stats.TimeEstimate, 12600)
+}
+
+func TestResetIssueSpentTime(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/4/issues/14/reset_spent_time")
+		w.Write([]byte(`{"time_estimate": 0, "total_time_spent": 0, "human_time_estimate": null, "human_total_time_spent": null}`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	stats, err := gitlab.ResetIssueSpentTime("4", 14)
+
+	assert.NoError(t, err)
+	assert.Equal(t, stats.TotalTimeSpent, 0)
+}
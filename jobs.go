@@ -12,6 +12,7 @@ import (
 
 var (
 	pipelineJobsUrl = path.Join(pipelineUrl, "jobs")
+	jobRetryUrl     = path.Join(project_url, "jobs", ":job_id", "retry")
 )
 
 type Job struct {
@@ -27,6 +28,7 @@ type Job struct {
 	Status string `json:"status"`
 	Tag bool `json:"tag"`
 	User User `json:"user"`
+	AllowFailure bool `json:"allow_failure"`
 }
 
 type ListJobsOpts struct {
@@ -122,4 +124,29 @@ func (g *Gitlab) ListPipelineJobs(projId string, pipelineId int, opts *ListJobsO
 	}
 
 	return js, nil
-}
\ No newline at end of file
+}
+
+// Retry a job, the new job is returned
+func (g *Gitlab) RetryJob(projId string, jobId int) (*Job, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodPost,
+		g.ResourceUrl(
+			jobRetryUrl,
+			map[string]string{
+				":id":     projId,
+				":job_id": strconv.Itoa(jobId),
+			},
+		),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request retry job API error: %v", err)
+	}
+
+	var j *Job
+	if err := json.Unmarshal(data, &j); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return j, nil
+}
@@ -0,0 +1,279 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+)
+
+var (
+	mergeRequestApproveUrl       = path.Join(project_url_merge_request, "approve")
+	mergeRequestUnapproveUrl     = path.Join(project_url_merge_request, "unapprove")
+	mergeRequestApprovalsUrl     = path.Join(project_url_merge_request, "approvals")
+	mergeRequestApprovalRulesUrl = path.Join(project_url_merge_request, "approval_rules")
+	mergeRequestApprovalRuleUrl  = path.Join(project_url_merge_request, "approval_rules", ":approval_rule_id")
+	projectApprovalRulesUrl      = path.Join(project_url, "approval_rules")
+	projectApprovalRuleUrl       = path.Join(project_url, "approval_rules", ":approval_rule_id")
+)
+
+// The approval state of a merge request
+type MergeRequestApprovals struct {
+	Id                int                     `json:"id"`
+	Iid               int                     `json:"iid"`
+	ProjectId         int                     `json:"project_id"`
+	Title             string                  `json:"title"`
+	State             string                  `json:"state"`
+	MergeStatus       string                  `json:"merge_status"`
+	Approved          bool                    `json:"approved"`
+	ApprovalsRequired int                     `json:"approvals_required"`
+	ApprovalsLeft     int                     `json:"approvals_left"`
+	ApprovedBy        []*MergeRequestApprover `json:"approved_by"`
+}
+
+type MergeRequestApprover struct {
+	User *User `json:"user"`
+}
+
+type ApprovalRule struct {
+	Id                            int                `json:"id"`
+	Name                          string             `json:"name"`
+	RuleType                      string             `json:"rule_type"`
+	ApprovalsRequired             int                `json:"approvals_required"`
+	EligibleApprovers             []*User            `json:"eligible_approvers"`
+	Users                         []*User            `json:"users"`
+	Groups                        []*Group           `json:"groups"`
+	ProtectedBranches             []*ProtectedBranch `json:"protected_branches,omitempty"`
+	AppliesToAllProtectedBranches bool               `json:"applies_to_all_protected_branches,omitempty"`
+	ContainsHiddenGroups          bool               `json:"contains_hidden_groups"`
+	// Only set on the merge request rules, the approvers who approved
+	ApprovedBy []*User `json:"approved_by,omitempty"`
+	Approved   bool    `json:"approved,omitempty"`
+}
+
+// Parameters of an approval rule, empty fields are left unchanged on update
+type ApprovalRuleOpts struct {
+	Name              string `json:"name,omitempty"`
+	ApprovalsRequired *int   `json:"approvals_required,omitempty"`
+	// The approvers, point to an empty slice to remove them all on update
+	UserIds  *[]int `json:"user_ids,omitempty"`
+	GroupIds *[]int `json:"group_ids,omitempty"`
+	// Project rules only, the branches the rule applies to, point to an
+	// empty slice to apply it to all branches again on update
+	ProtectedBranchIds            *[]int `json:"protected_branch_ids,omitempty"`
+	AppliesToAllProtectedBranches *bool  `json:"applies_to_all_protected_branches,omitempty"`
+	// Merge request rules only, the project rule the rule is copied from
+	ApprovalProjectRuleId int `json:"approval_project_rule_id,omitempty"`
+}
+
+func (opts *ApprovalRuleOpts) check(create bool) error {
+	if nil == opts {
+		return fmt.Errorf("Missing approval rule parameters")
+	}
+
+	if create && "" == opts.Name {
+		return fmt.Errorf("Missing name")
+	}
+
+	if create && nil == opts.ApprovalsRequired {
+		return fmt.Errorf("Missing approvals_required")
+	}
+
+	if nil != opts.ApprovalsRequired && *opts.ApprovalsRequired < 0 {
+		return fmt.Errorf("Invalid approvals_required '%d'", *opts.ApprovalsRequired)
+	}
+
+	return nil
+}
+
+// Whether GitLab refused an approval because the merge request head
+// changed since the SHA given to ApproveMergeRequest
+func IsApprovalSHAMismatchErr(err error) bool {
+	re, ok := err.(*respErr)
+	return ok && re.status == http.StatusConflict
+}
+
+func (g *Gitlab) execApprovals(method, u, id, merge_request_id string, body []byte) (*MergeRequestApprovals, error) {
+	data, err := g.buildAndExecRequest(method, g.ResourceUrl(u, mergeRequestParams(id, merge_request_id)), body)
+	if re, ok := err.(*respErr); ok && re.status == http.StatusConflict {
+		return nil, re
+	}
+	if nil != err {
+		return nil, fmt.Errorf("Request approvals API error: %v", err)
+	}
+
+	var a *MergeRequestApprovals
+	if err := json.Unmarshal(data, &a); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return a, nil
+}
+
+/*
+Approve a merge request as the authenticated user.
+
+    POST /projects/:id/merge_requests/:merge_request_iid/approve
+
+Parameters:
+
+    id               The ID of a project
+    merge_request_id The IID of a merge request
+    sha              The head SHA the approval is given for, may be empty
+
+When sha is set and the merge request head moved on, GitLab refuses the
+approval and IsApprovalSHAMismatchErr returns true for the error.
+*/
+func (g *Gitlab) ApproveMergeRequest(id, merge_request_id, sha string) (*MergeRequestApprovals, error) {
+	params := map[string]string{}
+	if "" != sha {
+		params["sha"] = sha
+	}
+
+	body, err := json.Marshal(params)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	return g.execApprovals(http.MethodPost, mergeRequestApproveUrl, id, merge_request_id, body)
+}
+
+// Withdraw the approval of the authenticated user
+func (g *Gitlab) UnapproveMergeRequest(id, merge_request_id string) error {
+	_, err := g.buildAndExecRequest(
+		http.MethodPost,
+		g.ResourceUrl(mergeRequestUnapproveUrl, mergeRequestParams(id, merge_request_id)),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request unapprove API error: %v", err)
+	}
+
+	return err
+}
+
+// Get the approval state of a merge request
+func (g *Gitlab) MergeRequestApprovals(id, merge_request_id string) (*MergeRequestApprovals, error) {
+	return g.execApprovals(http.MethodGet, mergeRequestApprovalsUrl, id, merge_request_id, nil)
+}
+
+func (g *Gitlab) listApprovalRules(u string, params map[string]string, page *Pagination) ([]*ApprovalRule, error) {
+	query, err := paginationQuery(page)
+	if nil != err {
+		return nil, fmt.Errorf("Check list approval rules parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(u, params, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list approval rules API error: %v", err)
+	}
+
+	var rs []*ApprovalRule
+	if err := json.Unmarshal(data, &rs); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return rs, nil
+}
+
+// Get, create or update an approval rule depending on the method
+func (g *Gitlab) execApprovalRule(method, u string, params map[string]string, opts *ApprovalRuleOpts) (*ApprovalRule, error) {
+	var body []byte
+	if http.MethodGet != method {
+		if err := opts.check(http.MethodPost == method); nil != err {
+			return nil, fmt.Errorf("Check approval rule parameters error: %v", err)
+		}
+
+		var err error
+		if body, err = json.Marshal(opts); nil != err {
+			return nil, fmt.Errorf("Encode request error: %v", err)
+		}
+	}
+
+	data, err := g.buildAndExecRequest(method, g.ResourceUrl(u, params), body)
+	if nil != err {
+		return nil, fmt.Errorf("Request approval rule API error: %v", err)
+	}
+
+	var r *ApprovalRule
+	if err := json.Unmarshal(data, &r); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return r, nil
+}
+
+func (g *Gitlab) deleteApprovalRule(u string, params map[string]string) error {
+	_, err := g.buildAndExecRequest(http.MethodDelete, g.ResourceUrl(u, params), nil)
+	if nil != err {
+		err = fmt.Errorf("Request delete approval rule API error: %v", err)
+	}
+
+	return err
+}
+
+func projectApprovalRuleParams(pid string, ruleId int) map[string]string {
+	return map[string]string{":id": pid, ":approval_rule_id": strconv.Itoa(ruleId)}
+}
+
+func mergeRequestApprovalRuleParams(id, merge_request_id string, ruleId int) map[string]string {
+	params := mergeRequestParams(id, merge_request_id)
+	params[":approval_rule_id"] = strconv.Itoa(ruleId)
+	return params
+}
+
+// List the approval rules of a project, merge requests start with a copy of them
+func (g *Gitlab) ProjectApprovalRules(pid string, page *Pagination) ([]*ApprovalRule, error) {
+	return g.listApprovalRules(projectApprovalRulesUrl, map[string]string{":id": pid}, page)
+}
+
+func (g *Gitlab) ProjectApprovalRule(pid string, ruleId int) (*ApprovalRule, error) {
+	return g.execApprovalRule(http.MethodGet, projectApprovalRuleUrl, projectApprovalRuleParams(pid, ruleId), nil)
+}
+
+func (g *Gitlab) CreateProjectApprovalRule(pid string, opts *ApprovalRuleOpts) (*ApprovalRule, error) {
+	return g.execApprovalRule(http.MethodPost, projectApprovalRulesUrl, map[string]string{":id": pid}, opts)
+}
+
+func (g *Gitlab) UpdateProjectApprovalRule(pid string, ruleId int, opts *ApprovalRuleOpts) (*ApprovalRule, error) {
+	return g.execApprovalRule(http.MethodPut, projectApprovalRuleUrl, projectApprovalRuleParams(pid, ruleId), opts)
+}
+
+func (g *Gitlab) DeleteProjectApprovalRule(pid string, ruleId int) error {
+	return g.deleteApprovalRule(projectApprovalRuleUrl, projectApprovalRuleParams(pid, ruleId))
+}
+
+func (g *Gitlab) MergeRequestApprovalRules(id, merge_request_id string, page *Pagination) ([]*ApprovalRule, error) {
+	return g.listApprovalRules(mergeRequestApprovalRulesUrl, mergeRequestParams(id, merge_request_id), page)
+}
+
+func (g *Gitlab) MergeRequestApprovalRule(id, merge_request_id string, ruleId int) (*ApprovalRule, error) {
+	return g.execApprovalRule(
+		http.MethodGet,
+		mergeRequestApprovalRuleUrl,
+		mergeRequestApprovalRuleParams(id, merge_request_id, ruleId),
+		nil,
+	)
+}
+
+func (g *Gitlab) CreateMergeRequestApprovalRule(id, merge_request_id string, opts *ApprovalRuleOpts) (*ApprovalRule, error) {
+	return g.execApprovalRule(http.MethodPost, mergeRequestApprovalRulesUrl, mergeRequestParams(id, merge_request_id), opts)
+}
+
+func (g *Gitlab) UpdateMergeRequestApprovalRule(id, merge_request_id string, ruleId int, opts *ApprovalRuleOpts) (*ApprovalRule, error) {
+	return g.execApprovalRule(
+		http.MethodPut,
+		mergeRequestApprovalRuleUrl,
+		mergeRequestApprovalRuleParams(id, merge_request_id, ruleId),
+		opts,
+	)
+}
+
+func (g *Gitlab) DeleteMergeRequestApprovalRule(id, merge_request_id string, ruleId int) error {
+	return g.deleteApprovalRule(mergeRequestApprovalRuleUrl, mergeRequestApprovalRuleParams(id, merge_request_id, ruleId))
+}
@@ -0,0 +1,171 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApproveMergeRequest(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/approvals/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/1/merge_requests/5/approve")
+
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		if "2be7ddb704c7b6b83732fdd5b9f09d5a397b5f8f" != body["sha"] {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"message":"SHA does not match HEAD of source branch"}`))
+			return
+		}
+
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	approvals, err := gitlab.ApproveMergeRequest("1", "5", "2be7ddb704c7b6b83732fdd5b9f09d5a397b5f8f")
+
+	assert.NoError(t, err)
+	assert.Equal(t, approvals.ApprovalsLeft, 1)
+	assert.Equal(t, approvals.ApprovedBy[0].User.Username, "root")
+
+	_, err = gitlab.ApproveMergeRequest("1", "5", "c380d3acebd181f13629a25d2e2acca46ffe1e00")
+	assert.Error(t, err)
+	assert.True(t, IsApprovalSHAMismatchErr(err))
+}
+
+func TestMergeRequestApprovals(t *testing.T) {
+	ts, gitlab := Stub("stubs/approvals/show.json")
+	defer ts.Close()
+
+	approvals, err := gitlab.MergeRequestApprovals("1", "5")
+
+	assert.NoError(t, err)
+	assert.Equal(t, approvals.Approved, false)
+	assert.Equal(t, approvals.ApprovalsRequired, 2)
+	assert.Equal(t, approvals.MergeStatus, "can_be_merged")
+}
+
+func TestUnapproveMergeRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/1/merge_requests/5/unapprove")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	assert.NoError(t, gitlab.UnapproveMergeRequest("1", "5"))
+}
+
+func TestProjectApprovalRules(t *testing.T) {
+	ts, gitlab := Stub("stubs/approvals/rules.json")
+	defer ts.Close()
+
+	rules, err := gitlab.ProjectApprovalRules("1", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(rules), 1)
+	assert.Equal(t, rules[0].Name, "security")
+	assert.Equal(t, rules[0].ApprovalsRequired, 3)
+	assert.Equal(t, len(rules[0].EligibleApprovers), 2)
+	assert.Equal(t, rules[0].Groups[0].FullPath, "group1")
+	assert.Equal(t, rules[0].ProtectedBranches[0].Name, "master")
+}
+
+func TestCreateProjectApprovalRule(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/approvals/rule.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/1/approval_rules")
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, body, map[string]interface{}{
+			"name":                 "security",
+			"approvals_required":   float64(1),
+			"user_ids":             []interface{}{float64(2)},
+			"protected_branch_ids": []interface{}{float64(1)},
+		})
+
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	required := 1
+	rule, err := gitlab.CreateProjectApprovalRule("1", &ApprovalRuleOpts{
+		Name:               "security",
+		ApprovalsRequired:  &required,
+		UserIds:            &[]int{2},
+		ProtectedBranchIds: &[]int{1},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, rule.Id, 1)
+	assert.Equal(t, rule.Users[0].Username, "jdoe")
+
+	_, err = gitlab.CreateProjectApprovalRule("1", &ApprovalRuleOpts{Name: "security"})
+	assert.EqualError(t, err, "Check approval rule parameters error: Missing approvals_required")
+}
+
+func TestUpdateProjectApprovalRuleAllBranches(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/approvals/rule.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPut)
+		assert.Equal(t, r.URL.Path, "/projects/1/approval_rules/1")
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, string(body), `{"protected_branch_ids":[]}`)
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	_, err := gitlab.UpdateProjectApprovalRule("1", 1, &ApprovalRuleOpts{ProtectedBranchIds: &[]int{}})
+
+	assert.NoError(t, err)
+}
+
+func TestUpdateMergeRequestApprovalRule(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/approvals/rule.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPut)
+		assert.Equal(t, r.URL.Path, "/projects/1/merge_requests/5/approval_rules/1")
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, string(body), `{"approvals_required":1,"user_ids":[]}`)
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	required := 1
+	rule, err := gitlab.UpdateMergeRequestApprovalRule("1", "5", 1, &ApprovalRuleOpts{
+		ApprovalsRequired: &required,
+		UserIds:           &[]int{},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, rule.ApprovalsRequired, 1)
+
+	invalid := -1
+	_, err = gitlab.UpdateMergeRequestApprovalRule("1", "5", 1, &ApprovalRuleOpts{ApprovalsRequired: &invalid})
+	assert.Error(t, err)
+}
+
+func TestDeleteMergeRequestApprovalRule(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodDelete)
+		assert.Equal(t, r.URL.Path, "/projects/1/merge_requests/5/approval_rules/1")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	assert.NoError(t, gitlab.DeleteMergeRequestApprovalRule("1", "5", 1))
+}
@@ -0,0 +1,236 @@
+package gogitlab
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// A step of AutoMergeMergeRequest
+type AutoMergeState string
+
+const (
+	AutoMergeChecking        = AutoMergeState("checking")
+	AutoMergeRebasing        = AutoMergeState("rebasing")
+	AutoMergeWaitingPipeline = AutoMergeState("waiting_pipeline")
+	AutoMergeRetryingJobs    = AutoMergeState("retrying_jobs")
+	AutoMergeMerging         = AutoMergeState("merging")
+	AutoMergeMerged          = AutoMergeState("merged")
+	AutoMergeFailed          = AutoMergeState("failed")
+)
+
+type AutoMergeOpts struct {
+	// Parameters of the merge, the SHA guard defaults to the checked head
+	Accept *AcceptMergeRequestRequest
+	// Number of times the failed jobs of a pipeline are retried
+	JobRetries int
+	// Delay between two checks, 10 seconds if not set
+	PollInterval time.Duration
+	// Give up after this delay, 1 hour if not set
+	Timeout time.Duration
+	// Called on every state change, mr is the last fetched merge request
+	OnTransition func(from, to AutoMergeState, mr *MergeRequest)
+}
+
+// Merge statuses that waiting does not clear, a person has to act on them
+var autoMergeBlockingStatus = map[string]bool{
+	"draft_status":             true,
+	"not_approved":             true,
+	"discussions_not_resolved": true,
+	"blocked_status":           true,
+	"not_open":                 true,
+	"requested_changes":        true,
+}
+
+type autoMerge struct {
+	g       *Gitlab
+	id      string
+	mrId    string
+	opts    AutoMergeOpts
+	state   AutoMergeState
+	retries int
+}
+
+func (a *autoMerge) transition(to AutoMergeState, mr *MergeRequest) {
+	if to == a.state {
+		return
+	}
+	from := a.state
+	a.state = to
+	if nil != a.opts.OnTransition {
+		a.opts.OnTransition(from, to, mr)
+	}
+}
+
+func (a *autoMerge) fail(mr *MergeRequest, format string, args ...interface{}) (*MergeRequest, error) {
+	a.transition(AutoMergeFailed, mr)
+	return mr, fmt.Errorf("Auto merge error: "+format, args...)
+}
+
+// Whether the merge request has to wait for its head pipeline, retrying
+// its failed jobs when allowed
+func (a *autoMerge) pipelinePending(mr *MergeRequest) (bool, error) {
+	if nil == mr.HeadPipeline {
+		return false, nil
+	}
+
+	p, err := a.g.GetPipeline(a.id, mr.HeadPipeline.Id)
+	if nil != err {
+		return false, err
+	}
+
+	switch p.Status {
+	case "success", "skipped", "manual":
+		return false, nil
+	case "failed":
+		if a.retries >= a.opts.JobRetries {
+			return false, fmt.Errorf("pipeline %d failed", p.Id)
+		}
+		a.retries++
+		a.transition(AutoMergeRetryingJobs, mr)
+		return true, a.retryFailedJobs(p.Id)
+	case "canceled":
+		return false, fmt.Errorf("pipeline %d canceled", p.Id)
+	}
+
+	a.transition(AutoMergeWaitingPipeline, mr)
+	return true, nil
+}
+
+func (a *autoMerge) retryFailedJobs(pipelineId int) error {
+	opts := &ListJobsOpts{Pagination: Pagination{Page: 1, PerPage: 100}}
+	opts.AddScope(JobScopeFailed)
+
+	// List every failed job before retrying any of them
+	var jobs []*Job
+	for {
+		page, err := a.g.ListPipelineJobs(a.id, pipelineId, opts)
+		if nil != err {
+			return err
+		}
+		jobs = append(jobs, page...)
+		if len(page) < opts.PerPage {
+			break
+		}
+		opts.Page++
+	}
+
+	for _, j := range jobs {
+		if j.AllowFailure {
+			continue
+		}
+		if _, err := a.g.RetryJob(a.id, j.Id); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *autoMerge) merge(mr *MergeRequest) (*MergeRequest, error) {
+	a.transition(AutoMergeMerging, mr)
+
+	accept := AcceptMergeRequestRequest{}
+	if nil != a.opts.Accept {
+		accept = *a.opts.Accept
+	}
+	if "" == accept.SHA {
+		accept.SHA = mr.SHA
+	}
+
+	return a.g.ProjectMergeRequestAccept(a.id, a.mrId, &accept)
+}
+
+/*
+Drive a merge request to merged, then return it.
+
+It waits for GitLab to compute the mergeability, rebases the source branch
+when it is behind the target branch, waits for the head pipeline, retries
+its failed jobs up to opts.JobRetries times and finally accepts the merge
+request with opts.Accept. Every step is reported to opts.OnTransition.
+
+An error is returned when the merge request is closed, has conflicts or
+is blocked by a status waiting cannot clear, like draft_status or
+not_approved, when its pipeline fails or is canceled, or when opts.Timeout
+is reached.
+*/
+func (g *Gitlab) AutoMergeMergeRequest(id, merge_request_id string, opts *AutoMergeOpts) (*MergeRequest, error) {
+	a := &autoMerge{g: g, id: id, mrId: merge_request_id}
+	if nil != opts {
+		a.opts = *opts
+	}
+	if a.opts.PollInterval <= 0 {
+		a.opts.PollInterval = 10 * time.Second
+	}
+	if a.opts.Timeout <= 0 {
+		a.opts.Timeout = time.Hour
+	}
+	deadline := time.Now().Add(a.opts.Timeout)
+
+	var mr *MergeRequest
+	for {
+		if time.Now().After(deadline) {
+			return a.fail(mr, "not merged after %s", a.opts.Timeout)
+		}
+
+		var err error
+		if mr, err = g.ProjectMergeRequest(id, merge_request_id); nil != err {
+			return a.fail(mr, "%v", err)
+		}
+		if "" == a.state {
+			a.transition(AutoMergeChecking, mr)
+		}
+
+		switch mr.State {
+		case "merged":
+			a.transition(AutoMergeMerged, mr)
+			return mr, nil
+		case "opened":
+		default:
+			return a.fail(mr, "merge request is %s", mr.State)
+		}
+
+		if mr.HasConflicts || "conflict" == mr.DetailedMergeStatus {
+			return a.fail(mr, "merge request has conflicts")
+		}
+
+		if autoMergeBlockingStatus[mr.DetailedMergeStatus] {
+			return a.fail(mr, "merge request is blocked: %s", mr.DetailedMergeStatus)
+		}
+
+		if "need_rebase" == mr.DetailedMergeStatus {
+			a.transition(AutoMergeRebasing, mr)
+			if err := g.RebaseMergeRequest(id, merge_request_id, false); nil != err {
+				return a.fail(mr, "%v", err)
+			}
+			if mr, err = g.WaitMergeRequestRebase(id, merge_request_id, a.opts.PollInterval, deadline.Sub(time.Now())); nil != err {
+				return a.fail(mr, "%v", err)
+			}
+			continue
+		}
+
+		pending, err := a.pipelinePending(mr)
+		if nil != err {
+			return a.fail(mr, "%v", err)
+		}
+
+		if !pending && ("mergeable" == mr.DetailedMergeStatus || ("" == mr.DetailedMergeStatus && "can_be_merged" == mr.MergeStatus)) {
+			merged, err := a.merge(mr)
+			if nil == err {
+				a.transition(AutoMergeMerged, merged)
+				return merged, nil
+			}
+
+			// Not mergeable anymore or the head moved on, check again
+			re, ok := err.(*respErr)
+			if !ok || (http.StatusMethodNotAllowed != re.status && http.StatusNotAcceptable != re.status && http.StatusConflict != re.status) {
+				return a.fail(mr, "%v", err)
+			}
+		}
+
+		if !pending {
+			a.transition(AutoMergeChecking, mr)
+		}
+		time.Sleep(a.opts.PollInterval)
+	}
+}
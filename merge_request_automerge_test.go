@@ -0,0 +1,151 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A merge request behind its target branch, whose pipeline fails once
+type autoMergeServer struct {
+	t              *testing.T
+	rebased        bool
+	pipelineChecks int
+	retried        []string
+	merged         bool
+	accept         map[string]interface{}
+}
+
+func (s *autoMergeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.Method + " " + r.URL.Path {
+	case "GET /projects/3/merge_requests/1":
+		mr := map[string]interface{}{"iid": 1, "state": "opened", "sha": "aaaa"}
+		switch {
+		case s.merged:
+			mr["state"] = "merged"
+		case !s.rebased:
+			mr["detailed_merge_status"] = "need_rebase"
+		default:
+			mr["sha"] = "bbbb"
+			mr["head_pipeline"] = map[string]interface{}{"id": 7, "status": "running"}
+			mr["detailed_merge_status"] = "ci_still_running"
+			if s.pipelineChecks > 3 {
+				mr["detailed_merge_status"] = "mergeable"
+			}
+		}
+		json.NewEncoder(w).Encode(mr)
+	case "PUT /projects/3/merge_requests/1/rebase":
+		s.rebased = true
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"rebase_in_progress": true}`))
+	case "GET /projects/3/pipelines/7":
+		s.pipelineChecks++
+		status := map[int]string{1: "running", 2: "failed", 3: "running"}[s.pipelineChecks]
+		if "" == status {
+			status = "success"
+		}
+		fmt.Fprintf(w, `{"id": 7, "status": "%s"}`, status)
+	case "GET /projects/3/pipelines/7/jobs":
+		assert.Equal(s.t, "failed", r.URL.Query().Get("scope[]"))
+		assert.Equal(s.t, "100", r.URL.Query().Get("per_page"))
+		// A full first page whose jobs may fail but job 11, then job 12
+		if "2" == r.URL.Query().Get("page") {
+			w.Write([]byte(`[{"id": 12, "status": "failed"}]`))
+			return
+		}
+		jobs := []string{`{"id": 11, "status": "failed"}`}
+		for i := 1; i < 100; i++ {
+			jobs = append(jobs, fmt.Sprintf(`{"id": %d, "status": "failed", "allow_failure": true}`, 100+i))
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(jobs, ","))
+	case "POST /projects/3/jobs/11/retry", "POST /projects/3/jobs/12/retry":
+		s.retried = append(s.retried, strings.Split(r.URL.Path, "/")[4])
+		w.Write([]byte(`{"id": 13, "status": "pending"}`))
+	case "PUT /projects/3/merge_requests/1/merge":
+		json.NewDecoder(r.Body).Decode(&s.accept)
+		s.merged = true
+		w.Write([]byte(`{"iid": 1, "state": "merged", "merge_commit_sha": "cccc"}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestAutoMergeMergeRequest(t *testing.T) {
+	s := &autoMergeServer{t: t}
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	var transitions []string
+	mr, err := gitlab.AutoMergeMergeRequest("3", "1", &AutoMergeOpts{
+		Accept:       &AcceptMergeRequestRequest{ShouldRemoveSourceBranch: true},
+		JobRetries:   1,
+		PollInterval: time.Millisecond,
+		Timeout:      5 * time.Second,
+		OnTransition: func(from, to AutoMergeState, mr *MergeRequest) {
+			transitions = append(transitions, string(to))
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, mr.State, "merged")
+	assert.Equal(t, mr.MergeCommitSHA, "cccc")
+	assert.Equal(t, transitions, []string{
+		"checking",
+		"rebasing",
+		"waiting_pipeline",
+		"retrying_jobs",
+		"waiting_pipeline",
+		"checking",
+		"merging",
+		"merged",
+	})
+	assert.Equal(t, s.retried, []string{"11", "12"})
+	assert.Equal(t, s.accept["sha"], "bbbb")
+	assert.Equal(t, s.accept["should_remove_source_branch"], true)
+}
+
+func TestAutoMergeMergeRequestPipelineFailed(t *testing.T) {
+	s := &autoMergeServer{t: t, rebased: true}
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	var last AutoMergeState
+	_, err := gitlab.AutoMergeMergeRequest("3", "1", &AutoMergeOpts{
+		PollInterval: time.Millisecond,
+		Timeout:      5 * time.Second,
+		OnTransition: func(from, to AutoMergeState, mr *MergeRequest) {
+			last = to
+		},
+	})
+
+	assert.EqualError(t, err, "Auto merge error: pipeline 7 failed")
+	assert.Equal(t, last, AutoMergeFailed)
+	assert.Equal(t, s.merged, false)
+}
+
+func TestAutoMergeMergeRequestBlocked(t *testing.T) {
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1")
+		polls++
+		w.Write([]byte(`{"iid": 1, "state": "opened", "detailed_merge_status": "not_approved"}`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	_, err := gitlab.AutoMergeMergeRequest("3", "1", &AutoMergeOpts{
+		PollInterval: time.Millisecond,
+		Timeout:      5 * time.Second,
+	})
+
+	assert.EqualError(t, err, "Auto merge error: merge request is blocked: not_approved")
+	assert.Equal(t, polls, 1)
+}
@@ -0,0 +1,113 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+)
+
+var (
+	mergeRequestVersionsUrl = path.Join(project_url_merge_request, "versions")
+	mergeRequestVersionUrl  = path.Join(project_url_merge_request, "versions", ":version_id")
+)
+
+// The state of a merge request after a push, the newest version comes first
+type MergeRequestDiffVersion struct {
+	Id             int        `json:"id"`
+	HeadCommitSHA  string     `json:"head_commit_sha"`
+	BaseCommitSHA  string     `json:"base_commit_sha"`
+	StartCommitSHA string     `json:"start_commit_sha"`
+	CreatedAt      *time.Time `json:"created_at"`
+	MergeRequestId int        `json:"merge_request_id"`
+	State          string     `json:"state"`
+	RealSize       string     `json:"real_size"`
+	// Only set by MergeRequestDiffVersion
+	Commits []*Commit     `json:"commits,omitempty"`
+	Diffs   []*ChangeItem `json:"diffs,omitempty"`
+}
+
+// The diff refs of the version, to comment a line of an older version
+func (v *MergeRequestDiffVersion) DiffRefs() *DiffRefs {
+	return &DiffRefs{
+		BaseSHA:  v.BaseCommitSHA,
+		StartSHA: v.StartCommitSHA,
+		HeadSHA:  v.HeadCommitSHA,
+	}
+}
+
+/*
+Whether some commits of prev are not part of the version anymore, meaning
+the source branch was force-pushed or rebased in between.
+
+Both versions must come from MergeRequestDiffVersion to have their commits.
+*/
+func (v *MergeRequestDiffVersion) Rewrites(prev *MergeRequestDiffVersion) bool {
+	ids := make(map[string]bool, len(v.Commits))
+	for _, c := range v.Commits {
+		ids[c.Id] = true
+	}
+
+	for _, c := range prev.Commits {
+		if !ids[c.Id] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// List the diff versions of a merge request, without their commits and diffs
+func (g *Gitlab) MergeRequestDiffVersions(id, merge_request_id string, page *Pagination) ([]*MergeRequestDiffVersion, error) {
+	query, err := paginationQuery(page)
+	if nil != err {
+		return nil, fmt.Errorf("Check list diff versions parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(mergeRequestVersionsUrl, mergeRequestParams(id, merge_request_id), query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list diff versions API error: %v", err)
+	}
+
+	var vs []*MergeRequestDiffVersion
+	if err := json.Unmarshal(data, &vs); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return vs, nil
+}
+
+/*
+Get a diff version of a merge request with its commits and diffs.
+
+    GET /projects/:id/merge_requests/:merge_request_iid/versions/:version_id
+
+Parameters:
+
+    id               The ID of a project
+    merge_request_id The IID of a merge request
+    versionId        The ID of a version, as listed by MergeRequestDiffVersions
+
+*/
+func (g *Gitlab) MergeRequestDiffVersion(id, merge_request_id string, versionId int) (*MergeRequestDiffVersion, error) {
+	params := mergeRequestParams(id, merge_request_id)
+	params[":version_id"] = strconv.Itoa(versionId)
+
+	data, err := g.buildAndExecRequest(http.MethodGet, g.ResourceUrl(mergeRequestVersionUrl, params), nil)
+	if nil != err {
+		return nil, fmt.Errorf("Request diff version API error: %v", err)
+	}
+
+	var v *MergeRequestDiffVersion
+	if err := json.Unmarshal(data, &v); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return v, nil
+}
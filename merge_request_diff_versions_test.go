@@ -0,0 +1,63 @@
+package gogitlab
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeRequestDiffVersions(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/versions/index.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1/versions")
+		assert.Equal(t, r.URL.Query().Get("per_page"), "50")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	vs, err := gitlab.MergeRequestDiffVersions("3", "1", &Pagination{PerPage: 50})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(vs), 2)
+	assert.Equal(t, vs[0].Id, 110)
+	assert.Equal(t, vs[1].HeadCommitSHA, "3eed087b29835c48015768f839d76e5ea8f07a24")
+	assert.Equal(t, len(vs[0].Commits), 0)
+}
+
+func TestMergeRequestDiffVersion(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/versions/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1/versions/110")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	v, err := gitlab.MergeRequestDiffVersion("3", "1", 110)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(v.Commits), 2)
+	assert.Equal(t, v.Commits[1].Title, "Update LICENSE")
+	assert.Equal(t, len(v.Diffs), 1)
+	assert.Equal(t, v.DiffRefs().HeadSHA, "33e2ee8579fda5bc36accc9c6fbd0b4fefda9e30")
+
+	f, err := v.Diffs[0].ParseDiff()
+	assert.NoError(t, err)
+	p, err := f.NewLinePosition(v.DiffRefs(), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, p.BaseSHA, "eeb57dffe83deb686a60a71c16c32f71046868fd")
+	assert.Equal(t, p.NewPath, "LICENSE")
+}
+
+func TestMergeRequestDiffVersionRewrites(t *testing.T) {
+	prev := &MergeRequestDiffVersion{Commits: []*Commit{{Id: "a"}}}
+	pushed := &MergeRequestDiffVersion{Commits: []*Commit{{Id: "b"}, {Id: "a"}}}
+	forced := &MergeRequestDiffVersion{Commits: []*Commit{{Id: "c"}}}
+
+	assert.Equal(t, pushed.Rewrites(prev), false)
+	assert.Equal(t, forced.Rewrites(prev), true)
+}
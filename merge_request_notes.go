@@ -0,0 +1,184 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+)
+
+var (
+	mergeRequestNotesUrl           = path.Join(project_url_merge_request, "notes")
+	mergeRequestNoteUrl            = path.Join(project_url_merge_request, "notes", ":note_id")
+	mergeRequestDiscussionsUrl     = path.Join(project_url_merge_request, "discussions")
+	mergeRequestDiscussionUrl      = path.Join(project_url_merge_request, "discussions", ":discussion_id")
+	mergeRequestDiscussionNotesUrl = path.Join(project_url_merge_request, "discussions", ":discussion_id", "notes")
+	mergeRequestDiscussionNoteUrl  = path.Join(project_url_merge_request, "discussions", ":discussion_id", "notes", ":note_id")
+)
+
+func mergeRequestParams(id, merge_request_id string) map[string]string {
+	return map[string]string{":id": id, ":merge_request_id": merge_request_id}
+}
+
+func mergeRequestNoteParams(id, merge_request_id string, noteId int) map[string]string {
+	params := mergeRequestParams(id, merge_request_id)
+	params[":note_id"] = strconv.Itoa(noteId)
+	return params
+}
+
+func mergeRequestDiscussionParams(id, merge_request_id, discussionId string) map[string]string {
+	params := mergeRequestParams(id, merge_request_id)
+	params[":discussion_id"] = discussionId
+	return params
+}
+
+/*
+Get the notes of a merge request, including the system notes.
+
+    GET /projects/:id/merge_requests/:merge_request_iid/notes
+
+Parameters:
+
+    id               The ID of a project
+    merge_request_id The IID of a merge request
+    opts             The order of the listing, may be nil
+
+*/
+func (g *Gitlab) MergeRequestNotes(id, merge_request_id string, opts *ListNotesOpts) ([]*Note, error) {
+	return g.listNotes(mergeRequestNotesUrl, mergeRequestParams(id, merge_request_id), opts)
+}
+
+func (g *Gitlab) MergeRequestNote(id, merge_request_id string, noteId int) (*Note, error) {
+	return g.execNote(http.MethodGet, mergeRequestNoteUrl, mergeRequestNoteParams(id, merge_request_id, noteId), nil)
+}
+
+// Comment on a merge request, see CreateMergeRequestDiscussion to comment a line
+func (g *Gitlab) CreateMergeRequestNote(id, merge_request_id string, opts *NoteOpts) (*Note, error) {
+	return g.execNote(http.MethodPost, mergeRequestNotesUrl, mergeRequestParams(id, merge_request_id), opts)
+}
+
+func (g *Gitlab) UpdateMergeRequestNote(id, merge_request_id string, noteId int, opts *NoteOpts) (*Note, error) {
+	return g.execNote(http.MethodPut, mergeRequestNoteUrl, mergeRequestNoteParams(id, merge_request_id, noteId), opts)
+}
+
+func (g *Gitlab) DeleteMergeRequestNote(id, merge_request_id string, noteId int) error {
+	return g.deleteNote(mergeRequestNoteUrl, mergeRequestNoteParams(id, merge_request_id, noteId))
+}
+
+func (g *Gitlab) execDiscussion(method, u string, params, query map[string]string, body []byte) (*Discussion, error) {
+	data, err := g.buildAndExecRequest(method, g.ResourceUrlWithQuery(u, params, query), body)
+	if nil != err {
+		return nil, fmt.Errorf("Request discussion API error: %v", err)
+	}
+
+	var d *Discussion
+	if err := json.Unmarshal(data, &d); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return d, nil
+}
+
+// Get the discussions of a merge request, standalone notes are single note discussions
+func (g *Gitlab) MergeRequestDiscussions(id, merge_request_id string, page *Pagination) ([]*Discussion, error) {
+	query, err := paginationQuery(page)
+	if nil != err {
+		return nil, fmt.Errorf("Check list discussions parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(mergeRequestDiscussionsUrl, mergeRequestParams(id, merge_request_id), query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list discussions API error: %v", err)
+	}
+
+	var ds []*Discussion
+	if err := json.Unmarshal(data, &ds); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return ds, nil
+}
+
+func (g *Gitlab) MergeRequestDiscussion(id, merge_request_id, discussionId string) (*Discussion, error) {
+	return g.execDiscussion(
+		http.MethodGet,
+		mergeRequestDiscussionUrl,
+		mergeRequestDiscussionParams(id, merge_request_id, discussionId),
+		nil,
+		nil,
+	)
+}
+
+/*
+Start a discussion on a merge request.
+
+    POST /projects/:id/merge_requests/:merge_request_iid/discussions
+
+Set opts.Position to start a thread on a line of the diff, its SHAs are
+the diff refs of the latest version of the merge request.
+
+Usage:
+
+	d, err := gitlab.CreateMergeRequestDiscussion("your_projet_id", "1", &CreateDiscussionOpts{
+		Body:     "Unused variable",
+		Position: &NotePosition{
+			BaseSHA:      "c380d3acebd181f13629a25d2e2acca46ffe1e00",
+			StartSHA:     "c380d3acebd181f13629a25d2e2acca46ffe1e00",
+			HeadSHA:      "2be7ddb704c7b6b83732fdd5b9f09d5a397b5f8f",
+			PositionType: PositionText,
+			NewPath:      "main.go",
+			NewLine:      18,
+		},
+	})
+
+*/
+func (g *Gitlab) CreateMergeRequestDiscussion(id, merge_request_id string, opts *CreateDiscussionOpts) (*Discussion, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check create discussion parameters error: %v", err)
+	}
+
+	body, err := json.Marshal(opts)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	return g.execDiscussion(http.MethodPost, mergeRequestDiscussionsUrl, mergeRequestParams(id, merge_request_id), nil, body)
+}
+
+// Resolve or unresolve a discussion of a merge request
+func (g *Gitlab) ResolveMergeRequestDiscussion(id, merge_request_id, discussionId string, resolved bool) (*Discussion, error) {
+	return g.execDiscussion(
+		http.MethodPut,
+		mergeRequestDiscussionUrl,
+		mergeRequestDiscussionParams(id, merge_request_id, discussionId),
+		map[string]string{"resolved": strconv.FormatBool(resolved)},
+		nil,
+	)
+}
+
+// Reply to a discussion of a merge request
+func (g *Gitlab) AddMergeRequestDiscussionNote(id, merge_request_id, discussionId string, opts *NoteOpts) (*Note, error) {
+	return g.execNote(
+		http.MethodPost,
+		mergeRequestDiscussionNotesUrl,
+		mergeRequestDiscussionParams(id, merge_request_id, discussionId),
+		opts,
+	)
+}
+
+func (g *Gitlab) UpdateMergeRequestDiscussionNote(id, merge_request_id, discussionId string, noteId int, opts *NoteOpts) (*Note, error) {
+	params := mergeRequestDiscussionParams(id, merge_request_id, discussionId)
+	params[":note_id"] = strconv.Itoa(noteId)
+	return g.execNote(http.MethodPut, mergeRequestDiscussionNoteUrl, params, opts)
+}
+
+func (g *Gitlab) DeleteMergeRequestDiscussionNote(id, merge_request_id, discussionId string, noteId int) error {
+	params := mergeRequestDiscussionParams(id, merge_request_id, discussionId)
+	params[":note_id"] = strconv.Itoa(noteId)
+	return g.deleteNote(mergeRequestDiscussionNoteUrl, params)
+}
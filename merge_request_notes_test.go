@@ -0,0 +1,169 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeRequestNotes(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/notes/index.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1/notes")
+		assert.Equal(t, r.URL.Query().Get("sort"), "asc")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	notes, err := gitlab.MergeRequestNotes("3", "1", &ListNotesOpts{Sort: "asc"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(notes), 2)
+	assert.Equal(t, notes[0].System, true)
+	assert.Equal(t, notes[1].Body, "Text of the comment\r\n")
+	assert.Equal(t, notes[1].Author.Username, "pipin")
+	assert.Equal(t, notes[1].NoteableType, "MergeRequest")
+
+	_, err = gitlab.MergeRequestNotes("3", "1", &ListNotesOpts{OrderBy: "id"})
+	assert.EqualError(t, err, "Check list notes parameters error: Invalid order_by 'id'")
+}
+
+func TestCreateMergeRequestNote(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/notes/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1/notes")
+
+		var opts NoteOpts
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&opts))
+		assert.Equal(t, opts.Body, "Text of the comment")
+
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	note, err := gitlab.CreateMergeRequestNote("3", "1", &NoteOpts{Body: "Text of the comment"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, note.Id, 305)
+
+	_, err = gitlab.CreateMergeRequestNote("3", "1", &NoteOpts{})
+	assert.EqualError(t, err, "Check note parameters error: Missing body")
+}
+
+func TestDeleteMergeRequestNote(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodDelete)
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1/notes/305")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	assert.NoError(t, gitlab.DeleteMergeRequestNote("3", "1", 305))
+}
+
+func TestMergeRequestDiscussions(t *testing.T) {
+	ts, gitlab := Stub("stubs/discussions/index.json")
+	defer ts.Close()
+
+	discussions, err := gitlab.MergeRequestDiscussions("3", "1", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(discussions), 2)
+	assert.Equal(t, discussions[0].Id, "6a9c1750b37d513a43987b574953fceb50b03ce7")
+	assert.Equal(t, len(discussions[0].Notes), 2)
+	assert.Equal(t, discussions[0].Notes[1].Body, "reply to the discussion")
+	assert.Equal(t, discussions[1].IndividualNote, true)
+}
+
+func TestCreateMergeRequestDiscussion(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/discussions/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1/discussions")
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, body["position"], map[string]interface{}{
+			"base_sha":      "b5d6e7b1613fca24d250fa8e5bc7bcc3dd6002ef",
+			"start_sha":     "7c9c2ead8a320fb7ba0b4e234bd9529a2614e306",
+			"head_sha":      "4803c71e6b1833ca72b8b26ef2ecd5adc8a38031",
+			"position_type": "text",
+			"old_path":      "package.json",
+			"new_path":      "package.json",
+			"old_line":      float64(27),
+			"new_line":      float64(27),
+		})
+
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	position := &NotePosition{
+		BaseSHA:      "b5d6e7b1613fca24d250fa8e5bc7bcc3dd6002ef",
+		StartSHA:     "7c9c2ead8a320fb7ba0b4e234bd9529a2614e306",
+		HeadSHA:      "4803c71e6b1833ca72b8b26ef2ecd5adc8a38031",
+		PositionType: PositionText,
+		OldPath:      "package.json",
+		NewPath:      "package.json",
+		OldLine:      27,
+		NewLine:      27,
+	}
+	d, err := gitlab.CreateMergeRequestDiscussion("3", "1", &CreateDiscussionOpts{
+		Body:     "Unused variable",
+		Position: position,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, d.Notes[0].Type, "DiffNote")
+	assert.Equal(t, d.Notes[0].Position.NewLine, 27)
+	assert.Equal(t, d.Notes[0].ResolvedBy.Username, "root")
+
+	position.OldLine, position.NewLine = 0, 0
+	_, err = gitlab.CreateMergeRequestDiscussion("3", "1", &CreateDiscussionOpts{
+		Body:     "Unused variable",
+		Position: position,
+	})
+	assert.EqualError(t, err, "Check create discussion parameters error: Missing position old_line or new_line")
+}
+
+func TestResolveMergeRequestDiscussion(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/discussions/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPut)
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1/discussions/87805b7c09016a7058e91bdbe7b29d1f284a39e6")
+		assert.Equal(t, r.URL.Query().Get("resolved"), "true")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	d, err := gitlab.ResolveMergeRequestDiscussion("3", "1", "87805b7c09016a7058e91bdbe7b29d1f284a39e6", true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, d.Notes[0].Resolved, true)
+}
+
+func TestAddMergeRequestDiscussionNote(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/notes/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1/discussions/6a9c1750b37d513a43987b574953fceb50b03ce7/notes")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	note, err := gitlab.AddMergeRequestDiscussionNote("3", "1", "6a9c1750b37d513a43987b574953fceb50b03ce7", &NoteOpts{Body: "Fixed"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, note.Id, 305)
+}
@@ -2,17 +2,32 @@ package gogitlab
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
 )
 
 const (
+	merge_requests_url                     = "/merge_requests"                                                                 // Get all merge requests the authenticated user has access to
+	group_url_merge_requests               = "/groups/:id/merge_requests"                                                      // Get group merge requests
 	project_url_merge_requests             = "/projects/:id/merge_requests"                                                    // Get project merge requests
 	project_url_merge_request              = "/projects/:id/merge_requests/:merge_request_id"                                  // Get information about a single merge request
 	project_url_merge_request_commits      = "/projects/:id/merge_requests/:merge_request_id/commits"                          // Get a list of merge request commits
 	project_url_merge_request_changes      = "/projects/:id/merge_requests/:merge_request_id/changes"                          // Shows information about the merge request including its files and changes
 	project_url_merge_request_merge        = "/projects/:id/merge_requests/:merge_request_id/merge"                            // Merge changes submitted with MR
 	project_url_merge_request_cancel_merge = "/projects/:id/merge_requests/:merge_request_id/cancel_merge_when_build_succeeds" // Cancel Merge When Build Succeeds
-	project_url_merge_request_comments     = "/projects/:id/merge_requests/:merge_request_id/comments"                         // Lists all comments associated with a merge request
+	project_url_merge_request_pipelines    = "/projects/:id/merge_requests/:merge_request_id/pipelines"                        // List or create merge request pipelines
+	project_url_merge_request_rebase       = "/projects/:id/merge_requests/:merge_request_id/rebase"                           // Rebase the source branch onto the target branch
+	project_url_merge_request_merge_ref    = "/projects/:id/merge_requests/:merge_request_id/merge_ref"                        // Get the commit of the merge ref
+)
+
+const (
+	project_url_merge_request_subscribe     = "/projects/:id/merge_requests/:merge_request_id/subscribe"     // Receive the notifications of a merge request
+	project_url_merge_request_unsubscribe   = "/projects/:id/merge_requests/:merge_request_id/unsubscribe"   // Stop receiving the notifications of a merge request
+	project_url_merge_request_participants  = "/projects/:id/merge_requests/:merge_request_id/participants"  // List the users involved in a merge request
+	project_url_merge_request_closes_issues = "/projects/:id/merge_requests/:merge_request_id/closes_issues" // List the issues closed on merge
 )
 
 type MergeRequest struct {
@@ -34,6 +49,27 @@ type MergeRequest struct {
 	MergeStatus     string `json:"merge_status,omitempty"`
 	SourceProjectID int    `json:"source_project_id,omitempty"`
 	TargetProjectID int    `json:"target_project_id,omitempty"`
+
+	// Head of the source branch and merge state, see also MergeStatus
+	SHA                 string    `json:"sha,omitempty"`
+	MergeCommitSHA      string    `json:"merge_commit_sha,omitempty"`
+	HeadPipeline        *Pipeline `json:"head_pipeline,omitempty"`
+	DiffRefs            *DiffRefs `json:"diff_refs,omitempty"`
+	HasConflicts        bool      `json:"has_conflicts,omitempty"`
+	DetailedMergeStatus string    `json:"detailed_merge_status,omitempty"`
+	Reviewers           []*User   `json:"reviewers,omitempty"`
+	// Only filled in by a GET with include_rebase_in_progress, as done by
+	// WaitMergeRequestRebase, and by the response of the rebase endpoint
+	RebaseInProgress bool   `json:"rebase_in_progress,omitempty"`
+	MergeError       string `json:"merge_error,omitempty"`
+}
+
+// The commits a merge request diff is computed from, the SHAs
+// expected by NotePosition
+type DiffRefs struct {
+	BaseSHA  string `json:"base_sha"`
+	HeadSHA  string `json:"head_sha"`
+	StartSHA string `json:"start_sha"`
 }
 
 type ChangeItem struct {
@@ -72,6 +108,187 @@ type AcceptMergeRequestRequest struct {
 	MergeCommitMessage       string `json:"merge_commit_message,omitempty"`
 	ShouldRemoveSourceBranch bool   `json:"should_remove_source_branch,omitempty"`
 	MergedWhenBuildSucceeds  bool   `json:"merged_when_build_succeeds,omitempty"`
+	// The merge fails when the source branch head is not this commit
+	SHA string `json:"sha,omitempty"`
+}
+
+// Filters of a merge requests listing, empty fields are ignored
+type ListMergeRequestsOpts struct {
+	// One of opened, closed, locked, merged or all
+	State string
+	// Either created_at or updated_at, created_at by default
+	OrderBy string
+	// Either asc or desc, desc by default
+	Sort string
+	// Milestone title, None or Any are also accepted
+	Milestone string
+	// Only the merge requests having all these labels
+	Labels       []string
+	AuthorId     int
+	AssigneeId   int
+	ReviewerId   int
+	SourceBranch string
+	TargetBranch string
+	// Only the draft merge requests when true, only the others when false
+	WIP           *bool
+	Search        string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+	// One of created_by_me, assigned_to_me or all
+	Scope string
+	Pagination
+}
+
+var (
+	validMergeRequestState = map[string]bool{
+		"opened": true,
+		"closed": true,
+		"locked": true,
+		"merged": true,
+		"all":    true,
+	}
+
+	validMergeRequestOrder = map[string]bool{
+		"created_at": true,
+		"updated_at": true,
+	}
+
+	validMergeRequestScope = map[string]bool{
+		"created_by_me":  true,
+		"assigned_to_me": true,
+		"all":            true,
+	}
+
+	validSort = map[string]bool{
+		"asc":  true,
+		"desc": true,
+	}
+)
+
+func (opts *ListMergeRequestsOpts) check() error {
+	if nil == opts {
+		return nil
+	}
+
+	if "" != opts.State && !validMergeRequestState[opts.State] {
+		return fmt.Errorf("Invalid state '%s'", opts.State)
+	}
+
+	if "" != opts.OrderBy && !validMergeRequestOrder[opts.OrderBy] {
+		return fmt.Errorf("Invalid order_by '%s'", opts.OrderBy)
+	}
+
+	if "" != opts.Sort && !validSort[opts.Sort] {
+		return fmt.Errorf("Invalid sort '%s'", opts.Sort)
+	}
+
+	if "" != opts.Scope && !validMergeRequestScope[opts.Scope] {
+		return fmt.Errorf("Invalid scope '%s'", opts.Scope)
+	}
+
+	if nil != opts.CreatedAfter && nil != opts.CreatedBefore && opts.CreatedBefore.Before(*opts.CreatedAfter) {
+		return fmt.Errorf("Invalid created_before '%s' before created_after '%s'", opts.CreatedBefore.Format(time.RFC3339), opts.CreatedAfter.Format(time.RFC3339))
+	}
+
+	if nil != opts.UpdatedAfter && nil != opts.UpdatedBefore && opts.UpdatedBefore.Before(*opts.UpdatedAfter) {
+		return fmt.Errorf("Invalid updated_before '%s' before updated_after '%s'", opts.UpdatedBefore.Format(time.RFC3339), opts.UpdatedAfter.Format(time.RFC3339))
+	}
+
+	return opts.Pagination.check()
+}
+
+func (opts *ListMergeRequestsOpts) toQuery() (map[string]string, error) {
+	if nil == opts {
+		return nil, nil
+	}
+
+	if err := opts.check(); nil != err {
+		return nil, err
+	}
+
+	query := make(map[string]string)
+	if "" != opts.State {
+		query["state"] = opts.State
+	}
+	if "" != opts.OrderBy {
+		query["order_by"] = opts.OrderBy
+	}
+	if "" != opts.Sort {
+		query["sort"] = opts.Sort
+	}
+	if "" != opts.Milestone {
+		query["milestone"] = opts.Milestone
+	}
+	if len(opts.Labels) > 0 {
+		query["labels"] = strings.Join(opts.Labels, ",")
+	}
+	if opts.AuthorId > 0 {
+		query["author_id"] = strconv.Itoa(opts.AuthorId)
+	}
+	if opts.AssigneeId > 0 {
+		query["assignee_id"] = strconv.Itoa(opts.AssigneeId)
+	}
+	if opts.ReviewerId > 0 {
+		query["reviewer_id"] = strconv.Itoa(opts.ReviewerId)
+	}
+	if "" != opts.SourceBranch {
+		query["source_branch"] = opts.SourceBranch
+	}
+	if "" != opts.TargetBranch {
+		query["target_branch"] = opts.TargetBranch
+	}
+	if nil != opts.WIP {
+		if *opts.WIP {
+			query["wip"] = "yes"
+		} else {
+			query["wip"] = "no"
+		}
+	}
+	if "" != opts.Search {
+		query["search"] = opts.Search
+	}
+	if nil != opts.CreatedAfter {
+		query["created_after"] = opts.CreatedAfter.Format(time.RFC3339)
+	}
+	if nil != opts.CreatedBefore {
+		query["created_before"] = opts.CreatedBefore.Format(time.RFC3339)
+	}
+	if nil != opts.UpdatedAfter {
+		query["updated_after"] = opts.UpdatedAfter.Format(time.RFC3339)
+	}
+	if nil != opts.UpdatedBefore {
+		query["updated_before"] = opts.UpdatedBefore.Format(time.RFC3339)
+	}
+	if "" != opts.Scope {
+		query["scope"] = opts.Scope
+	}
+	opts.Pagination.toQuery(query)
+	return query, nil
+}
+
+func (g *Gitlab) listMergeRequests(u string, params map[string]string, opts *ListMergeRequestsOpts) ([]*MergeRequest, error) {
+	query, err := opts.toQuery()
+	if nil != err {
+		return nil, fmt.Errorf("Check list merge requests parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(u, params, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list merge requests API error: %v", err)
+	}
+
+	var mrs []*MergeRequest
+	if err := json.Unmarshal(data, &mrs); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return mrs, nil
 }
 
 /*
@@ -81,33 +298,46 @@ Get list of project merge requests.
 
 Parameters:
 
-    id The ID of a project
+    id   The ID of a project
+    opts The filters of the listing, nil to list every merge request
 
-Params:
-	iid (optional) - Return the request having the given iid
-	state (optional) - Return all requests or just those that are merged, opened or closed
-	order_by (optional) - Return requests ordered by created_at or updated_at fields. Default is created_at
-	sort (optional) - Return requests sorted in asc or desc order. Default is desc
+Usage:
+
+	mrs, err := gitlab.ProjectMergeRequests("your_projet_id", &ListMergeRequestsOpts{
+		State:        "opened",
+		TargetBranch: "master",
+		Labels:       []string{"bug"},
+	})
 
 */
-func (g *Gitlab) ProjectMergeRequests(id string, params map[string]string) ([]*MergeRequest, error) {
-	url, opaque := g.ResourceUrlRaw(project_url_merge_requests, map[string]string{":id": id})
+func (g *Gitlab) ProjectMergeRequests(id string, opts *ListMergeRequestsOpts) ([]*MergeRequest, error) {
+	return g.listMergeRequests(project_url_merge_requests, map[string]string{":id": id}, opts)
+}
 
-	for name, value := range params {
-		url = url + "&" + name + "=" + value
-	}
+/*
+Get list of group merge requests, including the ones of its subgroups.
 
-	var err error
-	var mergeRequests []*MergeRequest
+    GET /groups/:id/merge_requests
 
-	contents, err := g.buildAndExecRequestRaw("GET", url, opaque, nil)
-	if err != nil {
-		return mergeRequests, err
-	}
+Parameters:
+
+    id   The ID of a group
+    opts The filters of the listing, see ProjectMergeRequests
+
+*/
+func (g *Gitlab) GroupMergeRequests(id string, opts *ListMergeRequestsOpts) ([]*MergeRequest, error) {
+	return g.listMergeRequests(group_url_merge_requests, map[string]string{":id": id}, opts)
+}
+
+/*
+Get list of the merge requests the authenticated user has access to,
+only the ones created by the user unless opts.Scope is set.
 
-	err = json.Unmarshal(contents, &mergeRequests)
+    GET /merge_requests
 
-	return mergeRequests, err
+*/
+func (g *Gitlab) MergeRequests(opts *ListMergeRequestsOpts) ([]*MergeRequest, error) {
+	return g.listMergeRequests(merge_requests_url, nil, opts)
 }
 
 /*
@@ -163,12 +393,6 @@ func (g *Gitlab) ProjectMergeRequestCommits(id, merge_request_id string) ([]*Com
 	contents, err := g.buildAndExecRequestRaw("GET", url, opaque, nil)
 	if err == nil {
 		err = json.Unmarshal(contents, &commits)
-		if err == nil {
-			for _, commit := range commits {
-				t, _ := time.Parse(dateLayout, commit.Created_At)
-				commit.CreatedAt = t
-			}
-		}
 	}
 
 	return commits, err
@@ -334,3 +558,335 @@ func (g *Gitlab) ProjectMergeRequestCancelMerge(id, merge_request_id string) (*M
 	}
 	return mr, nil
 }
+
+/*
+Get the pipelines of a merge request.
+
+    GET /projects/:id/merge_requests/:merge_request_iid/pipelines
+
+Parameters:
+
+    id               The ID of a project
+    merge_request_id The IID of a merge request
+
+*/
+func (g *Gitlab) MergeRequestPipelines(id, merge_request_id string) ([]*PipelineBrief, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrl(project_url_merge_request_pipelines, mergeRequestParams(id, merge_request_id)),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list merge request pipelines API error: %v", err)
+	}
+
+	var ps []*PipelineBrief
+	if err := json.Unmarshal(data, &ps); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return ps, nil
+}
+
+/*
+Create a merge request pipeline, the jobs need to be configured with
+`only: [merge_requests]` or rules.
+
+    POST /projects/:id/merge_requests/:merge_request_iid/pipelines
+
+Parameters:
+
+    id               The ID of a project
+    merge_request_id The IID of a merge request
+
+*/
+func (g *Gitlab) CreateMergeRequestPipeline(id, merge_request_id string) (*Pipeline, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodPost,
+		g.ResourceUrl(project_url_merge_request_pipelines, mergeRequestParams(id, merge_request_id)),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request create merge request pipeline API error: %v", err)
+	}
+
+	var p *Pipeline
+	if err := json.Unmarshal(data, &p); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return p, nil
+}
+
+/*
+Rebase the source branch of a merge request onto its target branch.
+
+    PUT /projects/:id/merge_requests/:merge_request_iid/rebase
+
+Parameters:
+
+    id               The ID of a project
+    merge_request_id The IID of a merge request
+    skipCI           Do not create a pipeline for the rebased commits
+
+The rebase is done asynchronously, use WaitMergeRequestRebase to wait for it.
+*/
+func (g *Gitlab) RebaseMergeRequest(id, merge_request_id string, skipCI bool) error {
+	var query map[string]string
+	if skipCI {
+		query = map[string]string{"skip_ci": "true"}
+	}
+
+	_, err := g.buildAndExecRequest(
+		http.MethodPut,
+		g.ResourceUrlWithQuery(project_url_merge_request_rebase, mergeRequestParams(id, merge_request_id), query),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request rebase merge request API error: %v", err)
+	}
+
+	return err
+}
+
+/*
+Poll a merge request every interval until its rebase is over and return it,
+or fail after timeout. An error is returned when the rebase failed.
+*/
+func (g *Gitlab) WaitMergeRequestRebase(id, merge_request_id string, interval, timeout time.Duration) (*MergeRequest, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		data, err := g.buildAndExecRequest(
+			http.MethodGet,
+			g.ResourceUrlWithQuery(
+				project_url_merge_request,
+				mergeRequestParams(id, merge_request_id),
+				map[string]string{"include_rebase_in_progress": "true"},
+			),
+			nil,
+		)
+		if nil != err {
+			return nil, fmt.Errorf("Request get merge request API error: %v", err)
+		}
+
+		var mr *MergeRequest
+		if err := json.Unmarshal(data, &mr); nil != err {
+			return nil, fmt.Errorf("Decode response error: %v", err)
+		}
+
+		if !mr.RebaseInProgress {
+			if "" != mr.MergeError {
+				return mr, fmt.Errorf("Rebase merge request error: %s", mr.MergeError)
+			}
+			return mr, nil
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return mr, fmt.Errorf("Rebase merge request error: still in progress after %s", timeout)
+		}
+		time.Sleep(interval)
+	}
+}
+
+/*
+Get the commit merging the merge request into its target branch, as
+GitLab would create it. It fails when the merge request can not be merged.
+
+    GET /projects/:id/merge_requests/:merge_request_iid/merge_ref
+
+*/
+func (g *Gitlab) MergeRequestMergeRef(id, merge_request_id string) (string, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrl(project_url_merge_request_merge_ref, mergeRequestParams(id, merge_request_id)),
+		nil,
+	)
+	if nil != err {
+		return "", fmt.Errorf("Request get merge ref API error: %v", err)
+	}
+
+	var ref struct {
+		CommitId string `json:"commit_id"`
+	}
+	if err := json.Unmarshal(data, &ref); nil != err {
+		return "", fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return ref.CommitId, nil
+}
+
+func (g *Gitlab) execMergeRequest(method, u, id, merge_request_id string, body []byte) (*MergeRequest, error) {
+	data, err := g.buildAndExecRequest(method, g.ResourceUrl(u, mergeRequestParams(id, merge_request_id)), body)
+	if nil != err {
+		return nil, fmt.Errorf("Request merge request API error: %v", err)
+	}
+
+	var mr *MergeRequest
+	if err := json.Unmarshal(data, &mr); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return mr, nil
+}
+
+func (g *Gitlab) setMergeRequestState(id, merge_request_id, event string) (*MergeRequest, error) {
+	body, err := json.Marshal(map[string]string{"state_event": event})
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	return g.execMergeRequest(http.MethodPut, project_url_merge_request, id, merge_request_id, body)
+}
+
+/*
+Close a merge request without merging it.
+
+    PUT /projects/:id/merge_requests/:merge_request_iid
+
+Parameters:
+
+    id               The ID of a project
+    merge_request_id The IID of a merge request
+
+*/
+func (g *Gitlab) CloseMergeRequest(id, merge_request_id string) (*MergeRequest, error) {
+	return g.setMergeRequestState(id, merge_request_id, "close")
+}
+
+// Reopen a closed merge request
+func (g *Gitlab) ReopenMergeRequest(id, merge_request_id string) (*MergeRequest, error) {
+	return g.setMergeRequestState(id, merge_request_id, "reopen")
+}
+
+/*
+Delete a merge request, only allowed to administrators and project owners.
+
+    DELETE /projects/:id/merge_requests/:merge_request_iid
+
+Parameters:
+
+    id               The ID of a project
+    merge_request_id The IID of a merge request
+
+*/
+func (g *Gitlab) DeleteMergeRequest(id, merge_request_id string) error {
+	_, err := g.buildAndExecRequest(
+		http.MethodDelete,
+		g.ResourceUrl(project_url_merge_request, mergeRequestParams(id, merge_request_id)),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request delete merge request API error: %v", err)
+	}
+
+	return err
+}
+
+// GitLab answers 304 Not Modified without a body when nothing changed
+func (g *Gitlab) setMergeRequestSubscription(u, id, merge_request_id string) error {
+	_, err := g.buildAndExecRequest(http.MethodPost, g.ResourceUrl(u, mergeRequestParams(id, merge_request_id)), nil)
+	if nil != err {
+		err = fmt.Errorf("Request merge request subscription API error: %v", err)
+	}
+
+	return err
+}
+
+// Subscribe the authenticated user to the notifications of a merge request,
+// subscribing twice is not an error
+func (g *Gitlab) SubscribeMergeRequest(id, merge_request_id string) error {
+	return g.setMergeRequestSubscription(project_url_merge_request_subscribe, id, merge_request_id)
+}
+
+// Unsubscribe the authenticated user from the notifications of a merge request
+func (g *Gitlab) UnsubscribeMergeRequest(id, merge_request_id string) error {
+	return g.setMergeRequestSubscription(project_url_merge_request_unsubscribe, id, merge_request_id)
+}
+
+/*
+Get the users who took part in a merge request: its author, assignees,
+reviewers and commenters.
+
+    GET /projects/:id/merge_requests/:merge_request_iid/participants
+
+Parameters:
+
+    id               The ID of a project
+    merge_request_id The IID of a merge request
+
+*/
+func (g *Gitlab) MergeRequestParticipants(id, merge_request_id string) ([]*User, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrl(project_url_merge_request_participants, mergeRequestParams(id, merge_request_id)),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list merge request participants API error: %v", err)
+	}
+
+	var users []*User
+	if err := json.Unmarshal(data, &users); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return users, nil
+}
+
+/*
+Get the issues the merge request closes once merged, as referenced by its
+description and commit messages.
+
+    GET /projects/:id/merge_requests/:merge_request_iid/closes_issues
+
+Parameters:
+
+    id               The ID of a project
+    merge_request_id The IID of a merge request
+    page             The page to get, may be nil
+
+*/
+func (g *Gitlab) MergeRequestClosesIssues(id, merge_request_id string, page *Pagination) ([]*Issue, error) {
+	query, err := paginationQuery(page)
+	if nil != err {
+		return nil, fmt.Errorf("Check list closed issues parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(project_url_merge_request_closes_issues, mergeRequestParams(id, merge_request_id), query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list closed issues API error: %v", err)
+	}
+
+	var issues []*Issue
+	if err := json.Unmarshal(data, &issues); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return issues, nil
+}
+
+// Set the estimated time of a merge request, duration is like 3h30m
+func (g *Gitlab) SetMergeRequestTimeEstimate(id, merge_request_id, duration string) (*TimeStats, error) {
+	return g.setTimeEstimate(project_url_merge_request, mergeRequestParams(id, merge_request_id), duration)
+}
+
+func (g *Gitlab) ResetMergeRequestTimeEstimate(id, merge_request_id string) (*TimeStats, error) {
+	return g.execTimeStats(http.MethodPost, project_url_merge_request, "reset_time_estimate", mergeRequestParams(id, merge_request_id), nil)
+}
+
+// Add time spent on a merge request, a negative duration like -1h removes time
+func (g *Gitlab) AddMergeRequestSpentTime(id, merge_request_id, duration, summary string) (*TimeStats, error) {
+	return g.addSpentTime(project_url_merge_request, mergeRequestParams(id, merge_request_id), duration, summary)
+}
+
+func (g *Gitlab) ResetMergeRequestSpentTime(id, merge_request_id string) (*TimeStats, error) {
+	return g.execTimeStats(http.MethodPost, project_url_merge_request, "reset_spent_time", mergeRequestParams(id, merge_request_id), nil)
+}
+
+func (g *Gitlab) MergeRequestTimeStats(id, merge_request_id string) (*TimeStats, error) {
+	return g.execTimeStats(http.MethodGet, project_url_merge_request, "time_stats", mergeRequestParams(id, merge_request_id), nil)
+}
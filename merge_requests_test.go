@@ -1,7 +1,12 @@
 package gogitlab
 
 import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -15,6 +20,89 @@ func TestProjectMergeRequests(t *testing.T) {
 	defer ts.Close()
 }
 
+func TestProjectMergeRequestsOpts(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/merge_requests/index.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests")
+		assert.Equal(t, r.URL.Query(), url.Values{
+			"state":         {"opened"},
+			"labels":        {"bug,feature request"},
+			"reviewer_id":   {"5"},
+			"target_branch": {"master"},
+			"wip":           {"no"},
+			"created_after": {"2017-01-01T00:00:00Z"},
+			"search":        {"fix&merge"},
+			"per_page":      {"20"},
+		})
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	wip := false
+	after := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	mrs, err := gitlab.ProjectMergeRequests("3", &ListMergeRequestsOpts{
+		State:        "opened",
+		Labels:       []string{"bug", "feature request"},
+		ReviewerId:   5,
+		TargetBranch: "master",
+		WIP:          &wip,
+		CreatedAfter: &after,
+		Search:       "fix&merge",
+		Pagination:   Pagination{PerPage: 20},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(mrs), 1)
+}
+
+func TestProjectMergeRequestsOptsCheck(t *testing.T) {
+	gitlab := NewGitlab("http://localhost", "", "")
+
+	_, err := gitlab.ProjectMergeRequests("3", &ListMergeRequestsOpts{State: "draft"})
+	assert.EqualError(t, err, "Check list merge requests parameters error: Invalid state 'draft'")
+
+	_, err = gitlab.GroupMergeRequests("1", &ListMergeRequestsOpts{Sort: "up"})
+	assert.EqualError(t, err, "Check list merge requests parameters error: Invalid sort 'up'")
+
+	after := time.Date(2017, 1, 2, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = gitlab.MergeRequests(&ListMergeRequestsOpts{UpdatedAfter: &after, UpdatedBefore: &before})
+	assert.Error(t, err)
+}
+
+func TestGroupMergeRequests(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/merge_requests/index.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/groups/1/merge_requests")
+		assert.Equal(t, r.URL.Query().Get("author_id"), "2")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	mrs, err := gitlab.GroupMergeRequests("1", &ListMergeRequestsOpts{AuthorId: 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(mrs), 1)
+}
+
+func TestMergeRequests(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/merge_requests/index.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/merge_requests")
+		assert.Equal(t, r.URL.Query().Get("scope"), "assigned_to_me")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	mrs, err := gitlab.MergeRequests(&ListMergeRequestsOpts{Scope: "assigned_to_me"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(mrs), 1)
+}
+
 func TestProjectMergeRequest(t *testing.T) {
 	ts, gitlab := Stub("stubs/merge_requests/show.json")
 	mr, err := gitlab.ProjectMergeRequest("3", "1")
@@ -24,6 +112,11 @@ func TestProjectMergeRequest(t *testing.T) {
 	assert.Equal(t, mr.MergeStatus, "can_be_merged")
 	assert.Equal(t, mr.SourceProjectID, 2)
 	assert.Equal(t, mr.TargetProjectID, 3)
+	assert.Equal(t, mr.DetailedMergeStatus, "mergeable")
+	assert.Equal(t, mr.SHA, "8888888888888888888888888888888888888888")
+	assert.Equal(t, mr.HeadPipeline.Status, "success")
+	assert.Equal(t, mr.DiffRefs.BaseSHA, "c380d3acebd181f13629a25d2e2acca46ffe1e00")
+	assert.Equal(t, mr.Reviewers[0].Username, "sam")
 	defer ts.Close()
 }
 
@@ -82,3 +175,192 @@ func TestProjectMergeRequestCancelMerge(t *testing.T) {
 	assert.Equal(t, err, nil)
 	defer ts.Close()
 }
+
+func TestMergeRequestPipelines(t *testing.T) {
+	ts, gitlab := Stub("stubs/merge_requests/pipelines.json")
+	defer ts.Close()
+
+	pipelines, err := gitlab.MergeRequestPipelines("3", "1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(pipelines), 1)
+	assert.Equal(t, pipelines[0].Id, 77)
+	assert.Equal(t, pipelines[0].Status, "success")
+}
+
+func TestCreateMergeRequestPipeline(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/merge_requests/pipeline.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1/pipelines")
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	pipeline, err := gitlab.CreateMergeRequestPipeline("3", "1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, pipeline.Ref, "refs/merge-requests/1/head")
+	assert.Equal(t, pipeline.Status, "pending")
+}
+
+func TestRebaseMergeRequest(t *testing.T) {
+	polls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1/rebase")
+		assert.Equal(t, r.Method, http.MethodPut)
+		assert.Equal(t, r.URL.Query().Get("skip_ci"), "true")
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"rebase_in_progress": true}`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	assert.NoError(t, gitlab.RebaseMergeRequest("3", "1", true))
+
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1")
+		assert.Equal(t, r.URL.Query().Get("include_rebase_in_progress"), "true")
+		polls++
+		if polls < 3 {
+			w.Write([]byte(`{"iid": 1, "rebase_in_progress": true}`))
+			return
+		}
+		w.Write([]byte(`{"iid": 1, "rebase_in_progress": false, "sha": "8888888888888888888888888888888888888888"}`))
+	}))
+	defer ts.Close()
+	gitlab = NewGitlab(ts.URL, "", "")
+
+	mr, err := gitlab.WaitMergeRequestRebase("3", "1", time.Millisecond, time.Second)
+
+	assert.NoError(t, err)
+	assert.Equal(t, polls, 3)
+	assert.Equal(t, mr.SHA, "8888888888888888888888888888888888888888")
+}
+
+func TestWaitMergeRequestRebaseError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"iid": 1, "rebase_in_progress": false, "merge_error": "Rebase failed: conflicts"}`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	_, err := gitlab.WaitMergeRequestRebase("3", "1", time.Millisecond, time.Second)
+	assert.EqualError(t, err, "Rebase merge request error: Rebase failed: conflicts")
+}
+
+func TestMergeRequestMergeRef(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1/merge_ref")
+		w.Write([]byte(`{"commit_id": "854a3a7a17acbcc0bbbea170986df1eb60435f34"}`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	commitId, err := gitlab.MergeRequestMergeRef("3", "1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, commitId, "854a3a7a17acbcc0bbbea170986df1eb60435f34")
+}
+
+func TestCloseMergeRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPut)
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1")
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, string(body), `{"state_event":"close"}`)
+		w.Write([]byte(`{"iid": 1, "state": "closed"}`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	mr, err := gitlab.CloseMergeRequest("3", "1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, mr.State, "closed")
+}
+
+func TestDeleteMergeRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodDelete)
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	assert.NoError(t, gitlab.DeleteMergeRequest("3", "1"))
+}
+
+func TestSubscribeMergeRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1/subscribe")
+		w.WriteHeader(http.StatusNotModified)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	assert.NoError(t, gitlab.SubscribeMergeRequest("3", "1"))
+}
+
+func TestMergeRequestParticipants(t *testing.T) {
+	ts, gitlab := Stub("stubs/merge_requests/participants.json")
+	defer ts.Close()
+
+	users, err := gitlab.MergeRequestParticipants("3", "1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(users), 2)
+	assert.Equal(t, users[1].Username, "user2")
+}
+
+func TestMergeRequestClosesIssues(t *testing.T) {
+	ts, gitlab := Stub("stubs/merge_requests/closes_issues.json")
+	defer ts.Close()
+
+	issues, err := gitlab.MergeRequestClosesIssues("3", "1", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(issues), 1)
+	assert.Equal(t, issues[0].IId, 6)
+	assert.Equal(t, issues[0].Author.Username, "root")
+}
+
+func TestAddMergeRequestSpentTime(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPost)
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1/add_spent_time")
+		assert.Equal(t, r.URL.Query().Get("duration"), "1h30m")
+		assert.Equal(t, r.URL.Query().Get("summary"), "review")
+		w.Write([]byte(`{"time_estimate": 0, "total_time_spent": 5400, "human_time_estimate": null, "human_total_time_spent": "1h 30m"}`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	stats, err := gitlab.AddMergeRequestSpentTime("3", "1", "1h30m", "review")
+
+	assert.NoError(t, err)
+	assert.Equal(t, stats.TotalTimeSpent, 5400)
+	assert.Equal(t, stats.HumanTotalTimeSpent, "1h 30m")
+
+	_, err = gitlab.SetMergeRequestTimeEstimate("3", "1", "")
+	assert.EqualError(t, err, "Check time_estimate parameters error: Missing duration")
+}
+
+func TestMergeRequestTimeStats(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodGet)
+		assert.Equal(t, r.URL.Path, "/projects/3/merge_requests/1/time_stats")
+		w.Write([]byte(`{"time_estimate": 10800, "total_time_spent": 0, "human_time_estimate": "3h", "human_total_time_spent": null}`))
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	stats, err := gitlab.MergeRequestTimeStats("3", "1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, stats.TimeEstimate, 10800)
+	assert.Equal(t, stats.HumanTimeEstimate, "3h")
+}
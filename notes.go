@@ -0,0 +1,213 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	PositionText  = "text"
+	PositionImage = "image"
+)
+
+// A comment on a merge request, an issue or a snippet
+type Note struct {
+	Id           int        `json:"id"`
+	Type         string     `json:"type"` // DiffNote or DiscussionNote in threads, empty otherwise
+	Body         string     `json:"body"`
+	Author       *User      `json:"author"`
+	CreatedAt    *time.Time `json:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+	System       bool       `json:"system"`
+	NoteableId   int        `json:"noteable_id"`
+	NoteableIid  int        `json:"noteable_iid"`
+	NoteableType string     `json:"noteable_type"`
+	Confidential bool       `json:"confidential"`
+	Internal     bool       `json:"internal"`
+	Resolvable   bool       `json:"resolvable"`
+	Resolved     bool       `json:"resolved"`
+	ResolvedBy   *User      `json:"resolved_by"`
+	// Only set on the notes attached to a line of a diff
+	Position *NotePosition `json:"position,omitempty"`
+}
+
+/*
+The line of a merge request diff a discussion is attached to.
+
+The SHAs come from the diff refs of the merge request. Set only NewLine
+for an added line, only OldLine for a removed line and both for an
+unchanged line.
+*/
+type NotePosition struct {
+	BaseSHA      string `json:"base_sha"`
+	StartSHA     string `json:"start_sha"`
+	HeadSHA      string `json:"head_sha"`
+	PositionType string `json:"position_type"`
+	OldPath      string `json:"old_path,omitempty"`
+	NewPath      string `json:"new_path,omitempty"`
+	OldLine      int    `json:"old_line,omitempty"`
+	NewLine      int    `json:"new_line,omitempty"`
+}
+
+type NoteOpts struct {
+	Body string `json:"body"`
+	// Only visible to the project members, on creation only
+	Internal bool `json:"internal,omitempty"`
+}
+
+type ListNotesOpts struct {
+	// Either created_at or updated_at, created_at by default
+	OrderBy string
+	// Either asc or desc, desc by default
+	Sort string
+	Pagination
+}
+
+// A thread of notes, IndividualNote is set for standalone comments
+type Discussion struct {
+	Id             string  `json:"id"`
+	IndividualNote bool    `json:"individual_note"`
+	Notes          []*Note `json:"notes"`
+}
+
+type CreateDiscussionOpts struct {
+	Body string `json:"body"`
+	// Attach the discussion to a line of the diff, nil for a general thread
+	Position *NotePosition `json:"position,omitempty"`
+	// Start the discussion on a commit of the merge request
+	CommitId string `json:"commit_id,omitempty"`
+}
+
+var validNoteOrder = map[string]bool{
+	"created_at": true,
+	"updated_at": true,
+}
+
+func (opts *ListNotesOpts) toQuery() (map[string]string, error) {
+	if nil == opts {
+		return nil, nil
+	}
+
+	if "" != opts.OrderBy && !validNoteOrder[opts.OrderBy] {
+		return nil, fmt.Errorf("Invalid order_by '%s'", opts.OrderBy)
+	}
+
+	if "" != opts.Sort && !validSort[opts.Sort] {
+		return nil, fmt.Errorf("Invalid sort '%s'", opts.Sort)
+	}
+
+	if err := opts.Pagination.check(); nil != err {
+		return nil, err
+	}
+
+	query := make(map[string]string)
+	if "" != opts.OrderBy {
+		query["order_by"] = opts.OrderBy
+	}
+	if "" != opts.Sort {
+		query["sort"] = opts.Sort
+	}
+	opts.Pagination.toQuery(query)
+	return query, nil
+}
+
+func (opts *NoteOpts) check() error {
+	if nil == opts || "" == opts.Body {
+		return fmt.Errorf("Missing body")
+	}
+	return nil
+}
+
+func (p *NotePosition) check() error {
+	if "" == p.BaseSHA || "" == p.StartSHA || "" == p.HeadSHA {
+		return fmt.Errorf("Missing position base_sha, start_sha or head_sha")
+	}
+
+	if PositionText != p.PositionType && PositionImage != p.PositionType {
+		return fmt.Errorf("Invalid position_type '%s'", p.PositionType)
+	}
+
+	if PositionText == p.PositionType {
+		if "" == p.OldPath && "" == p.NewPath {
+			return fmt.Errorf("Missing position old_path or new_path")
+		}
+		if p.OldLine <= 0 && p.NewLine <= 0 {
+			return fmt.Errorf("Missing position old_line or new_line")
+		}
+	}
+
+	return nil
+}
+
+func (opts *CreateDiscussionOpts) check() error {
+	if nil == opts || "" == opts.Body {
+		return fmt.Errorf("Missing body")
+	}
+
+	if nil != opts.Position {
+		return opts.Position.check()
+	}
+
+	return nil
+}
+
+func (g *Gitlab) listNotes(u string, params map[string]string, opts *ListNotesOpts) ([]*Note, error) {
+	query, err := opts.toQuery()
+	if nil != err {
+		return nil, fmt.Errorf("Check list notes parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(u, params, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list notes API error: %v", err)
+	}
+
+	var notes []*Note
+	if err := json.Unmarshal(data, &notes); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return notes, nil
+}
+
+// Get, create or update a note depending on the method, opts may be nil to get it
+func (g *Gitlab) execNote(method, u string, params map[string]string, opts *NoteOpts) (*Note, error) {
+	var body []byte
+	if http.MethodGet != method {
+		if err := opts.check(); nil != err {
+			return nil, fmt.Errorf("Check note parameters error: %v", err)
+		}
+
+		var err error
+		if body, err = json.Marshal(opts); nil != err {
+			return nil, fmt.Errorf("Encode request error: %v", err)
+		}
+	}
+
+	data, err := g.buildAndExecRequest(method, g.ResourceUrl(u, params), body)
+	if nil != err {
+		return nil, fmt.Errorf("Request note API error: %v", err)
+	}
+
+	var note *Note
+	if err := json.Unmarshal(data, &note); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return note, nil
+}
+
+func (g *Gitlab) deleteNote(u string, params map[string]string) error {
+	_, err := g.buildAndExecRequest(http.MethodDelete, g.ResourceUrl(u, params), nil)
+	if nil != err {
+		err = fmt.Errorf("Request delete note API error: %v", err)
+	}
+
+	return err
+}
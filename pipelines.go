@@ -254,6 +254,21 @@ func (p Pagination) check() error {
 	return nil
 }
 
+// Build the query of a listing only accepting pagination, page may be nil
+func paginationQuery(page *Pagination) (map[string]string, error) {
+	if nil == page {
+		return nil, nil
+	}
+
+	if err := page.check(); nil != err {
+		return nil, err
+	}
+
+	query := make(map[string]string)
+	page.toQuery(query)
+	return query, nil
+}
+
 func (p Pagination) toQuery(query map[string]string) {
 	if p.Page > 0 {
 		query["page"] = strconv.Itoa(p.Page)
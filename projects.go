@@ -2,18 +2,21 @@ package gogitlab
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
 	"strconv"
 )
 
 const (
-	projects_url         = "/projects"                         // Get a list of projects owned by the authenticated user
-	projects_all         = "/projects/all"                     // Get a list of all GitLab projects (admin only)
-	projects_search_url  = "/projects/search/:query"           // Search for projects by name
-	project_url          = "/projects/:id"                     // Get a specific project, identified by project ID or NAME
-	project_url_events   = "/projects/:id/events"              // Get project events
-	project_url_branches = "/projects/:id/repository/branches" // Lists all branches of a project
-	project_url_members  = "/projects/:id/members"             // List project team members
-	project_url_member   = "/projects/:id/members/:user_id"    // Get project team member
+	projects_url          = "/projects"                         // Get a list of projects owned by the authenticated user
+	projects_all          = "/projects/all"                     // Get a list of all GitLab projects (admin only)
+	projects_search_url   = "/projects/search/:query"           // Search for projects by name
+	project_url           = "/projects/:id"                     // Get a specific project, identified by project ID or NAME
+	project_url_events    = "/projects/:id/events"              // Get project events
+	project_url_branches  = "/projects/:id/repository/branches" // Lists all branches of a project
+	project_url_members   = "/projects/:id/members"             // List project team members
+	project_url_member    = "/projects/:id/members/:user_id"    // Get project team member
+	project_url_languages = "/projects/:id/languages"           // Get project languages
 )
 
 type Member struct {
@@ -71,6 +74,33 @@ type Project struct {
 	HttpRepoUrl          string     `json:"http_url_to_repo"`
 	WebUrl               string     `json:"web_url"`
 	SharedRunners        bool       `json:"shared_runners_enabled"`
+	// Set with UpdateProjectAvatar
+	AvatarUrl string `json:"avatar_url,omitempty"`
+	// Only set when requested, see ProjectWithStatistics
+	Statistics *ProjectStatistics `json:"statistics,omitempty"`
+}
+
+// Storage usage of a project, sizes are in bytes
+type ProjectStatistics struct {
+	CommitCount      int64 `json:"commit_count"`
+	StorageSize      int64 `json:"storage_size"`
+	RepositorySize   int64 `json:"repository_size"`
+	WikiSize         int64 `json:"wiki_size"`
+	LfsObjectsSize   int64 `json:"lfs_objects_size"`
+	JobArtifactsSize int64 `json:"job_artifacts_size"`
+	PackagesSize     int64 `json:"packages_size"`
+	SnippetsSize     int64 `json:"snippets_size"`
+}
+
+func (s *ProjectStatistics) add(o *ProjectStatistics) {
+	s.CommitCount += o.CommitCount
+	s.StorageSize += o.StorageSize
+	s.RepositorySize += o.RepositorySize
+	s.WikiSize += o.WikiSize
+	s.LfsObjectsSize += o.LfsObjectsSize
+	s.JobArtifactsSize += o.JobArtifactsSize
+	s.PackagesSize += o.PackagesSize
+	s.SnippetsSize += o.SnippetsSize
 }
 
 func projects(u string, g *Gitlab) ([]*Project, error) {
@@ -163,6 +193,52 @@ func (g *Gitlab) Project(id string) (*Project, error) {
 	return project, err
 }
 
+/*
+Get a specific project along with its statistics, see Project.
+
+The statistics are only available to the project maintainers.
+*/
+func (g *Gitlab) ProjectWithStatistics(id string) (*Project, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(project_url, map[string]string{":id": id}, map[string]string{"statistics": "true"}),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request get project API error: %v", err)
+	}
+
+	var project *Project
+	if err := json.Unmarshal(data, &project); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return project, nil
+}
+
+/*
+Get the languages used in a project with their percentage, e.g.
+
+	{"Go": 80.5, "Shell": 19.5}
+*/
+func (g *Gitlab) ProjectLanguages(id string) (map[string]float64, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrl(project_url_languages, map[string]string{":id": id}),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request get project languages API error: %v", err)
+	}
+
+	var languages map[string]float64
+	if err := json.Unmarshal(data, &languages); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return languages, nil
+}
+
 func (g *Gitlab) CreateProject(project *Project) (*Project, error) {
 	return g.AddProject(project)
 }
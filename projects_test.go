@@ -1,6 +1,10 @@
 package gogitlab
 
 import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -27,6 +31,30 @@ func TestProject(t *testing.T) {
 	defer ts.Close()
 }
 
+func TestProjectWithStatistics(t *testing.T) {
+	ts, gitlab := Stub("stubs/projects/statistics.json")
+	defer ts.Close()
+
+	project, err := gitlab.ProjectWithStatistics("3")
+
+	assert.NoError(t, err)
+	assert.Equal(t, project.Id, 3)
+	assert.Equal(t, project.Statistics.CommitCount, int64(37))
+	assert.Equal(t, project.Statistics.StorageSize, int64(1038090))
+	assert.Equal(t, project.Statistics.LfsObjectsSize, int64(0))
+}
+
+func TestProjectLanguages(t *testing.T) {
+	ts, gitlab := Stub("stubs/projects/languages.json")
+	defer ts.Close()
+
+	languages, err := gitlab.ProjectLanguages("3")
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(languages), 3)
+	assert.Equal(t, languages["Go"], 80.5)
+}
+
 func TestAddProject(t *testing.T) {
 	ts, gitlab := Stub("stubs/projects/add.json")
 	defer ts.Close()
@@ -76,3 +104,29 @@ func TestRemoveProject(t *testing.T) {
 	assert.Equal(t, err, nil)
 	assert.Equal(t, result, true)
 }
+
+func TestUpdateProjectAvatar(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/projects/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPut)
+		assert.Equal(t, r.URL.Path, "/projects/3")
+
+		f, h, err := r.FormFile("avatar")
+		assert.NoError(t, err)
+		assert.Equal(t, h.Filename, "logo.png")
+		content, _ := ioutil.ReadAll(f)
+		assert.Equal(t, string(content), "png data")
+
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	project, err := gitlab.UpdateProjectAvatar("3", "logo.png", strings.NewReader("png data"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, project.Id, 3)
+
+	_, err = gitlab.UpdateProjectAvatar("3", "", nil)
+	assert.Error(t, err)
+}
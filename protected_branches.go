@@ -0,0 +1,208 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+var (
+	protectedBranchesUrl = path.Join(project_url, "protected_branches")
+	protectedBranchUrl   = path.Join(project_url, "protected_branches", ":name")
+)
+
+type AccessLevel int
+
+const (
+	NoAccess         AccessLevel = 0
+	DeveloperAccess  AccessLevel = 30
+	MaintainerAccess AccessLevel = 40
+	AdminAccess      AccessLevel = 60
+)
+
+// Returns a pointer to l, handy to fill optional access levels
+func (l AccessLevel) Ptr() *AccessLevel {
+	return &l
+}
+
+type AccessLevelDescription struct {
+	Id                     int         `json:"id,omitempty"`
+	AccessLevel            AccessLevel `json:"access_level"`
+	AccessLevelDescription string      `json:"access_level_description,omitempty"`
+	UserId                 int         `json:"user_id,omitempty"`
+	GroupId                int         `json:"group_id,omitempty"`
+}
+
+type ProtectedBranch struct {
+	Id                        int                       `json:"id"`
+	Name                      string                    `json:"name"`
+	PushAccessLevels          []*AccessLevelDescription `json:"push_access_levels"`
+	MergeAccessLevels         []*AccessLevelDescription `json:"merge_access_levels"`
+	UnprotectAccessLevels     []*AccessLevelDescription `json:"unprotect_access_levels"`
+	AllowForcePush            bool                      `json:"allow_force_push"`
+	CodeOwnerApprovalRequired bool                      `json:"code_owner_approval_required"`
+}
+
+// Parameters of a branch protection, nil access levels are left to
+// the GitLab defaults (maintainers)
+type ProtectBranchOpts struct {
+	Name                      string       `json:"name"`
+	PushAccessLevel           *AccessLevel `json:"push_access_level,omitempty"`
+	MergeAccessLevel          *AccessLevel `json:"merge_access_level,omitempty"`
+	UnprotectAccessLevel      *AccessLevel `json:"unprotect_access_level,omitempty"`
+	AllowForcePush            bool         `json:"allow_force_push,omitempty"`
+	CodeOwnerApprovalRequired bool         `json:"code_owner_approval_required,omitempty"`
+}
+
+// Settings of an existing protected branch, nil fields are left unchanged
+type UpdateProtectedBranchOpts struct {
+	AllowForcePush            *bool `json:"allow_force_push,omitempty"`
+	CodeOwnerApprovalRequired *bool `json:"code_owner_approval_required,omitempty"`
+}
+
+var validAccessLevel = map[AccessLevel]bool{
+	NoAccess:         true,
+	DeveloperAccess:  true,
+	MaintainerAccess: true,
+	AdminAccess:      true,
+}
+
+func checkAccessLevel(name string, l *AccessLevel) error {
+	if nil != l && !validAccessLevel[*l] {
+		return fmt.Errorf("Invalid %s '%d'", name, *l)
+	}
+	return nil
+}
+
+func (opts *ProtectBranchOpts) check() error {
+	if nil == opts || "" == opts.Name {
+		return fmt.Errorf("Missing branch name")
+	}
+
+	if err := checkAccessLevel("push_access_level", opts.PushAccessLevel); nil != err {
+		return err
+	}
+
+	if err := checkAccessLevel("merge_access_level", opts.MergeAccessLevel); nil != err {
+		return err
+	}
+
+	return checkAccessLevel("unprotect_access_level", opts.UnprotectAccessLevel)
+}
+
+func (g *Gitlab) ProtectedBranches(pid string, page *Pagination) ([]*ProtectedBranch, error) {
+	query, err := paginationQuery(page)
+	if nil != err {
+		return nil, fmt.Errorf("Check list protected branches parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(protectedBranchesUrl, map[string]string{":id": pid}, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list protected branches API error: %v", err)
+	}
+
+	var bs []*ProtectedBranch
+	if err := json.Unmarshal(data, &bs); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return bs, nil
+}
+
+// Get a protected branch or wildcard, name may be a wildcard like `release-*`
+func (g *Gitlab) ProtectedBranch(pid, name string) (*ProtectedBranch, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrl(protectedBranchUrl, map[string]string{
+			":id":   pid,
+			":name": url.PathEscape(name),
+		}),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request get protected branch API error: %v", err)
+	}
+
+	var b *ProtectedBranch
+	if err := json.Unmarshal(data, &b); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return b, nil
+}
+
+// Protect a single branch or several branches using a wildcard
+func (g *Gitlab) ProtectBranch(pid string, opts *ProtectBranchOpts) (*ProtectedBranch, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check protect branch parameters error: %v", err)
+	}
+
+	body, err := json.Marshal(opts)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodPost,
+		g.ResourceUrl(protectedBranchesUrl, map[string]string{":id": pid}),
+		body,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request protect branch API error: %v", err)
+	}
+
+	var b *ProtectedBranch
+	if err := json.Unmarshal(data, &b); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return b, nil
+}
+
+func (g *Gitlab) UpdateProtectedBranch(pid, name string, opts *UpdateProtectedBranchOpts) (*ProtectedBranch, error) {
+	body, err := json.Marshal(opts)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodPatch,
+		g.ResourceUrl(protectedBranchUrl, map[string]string{
+			":id":   pid,
+			":name": url.PathEscape(name),
+		}),
+		body,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request update protected branch API error: %v", err)
+	}
+
+	var b *ProtectedBranch
+	if err := json.Unmarshal(data, &b); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return b, nil
+}
+
+func (g *Gitlab) UnprotectBranch(pid, name string) error {
+	_, err := g.buildAndExecRequest(
+		http.MethodDelete,
+		g.ResourceUrl(protectedBranchUrl, map[string]string{
+			":id":   pid,
+			":name": url.PathEscape(name),
+		}),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request unprotect branch API error: %v", err)
+	}
+
+	return err
+}
@@ -0,0 +1,83 @@
+package gogitlab
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtectedBranches(t *testing.T) {
+	ts, gitlab := Stub("stubs/protected_branches/index.json")
+	defer ts.Close()
+
+	bs, err := gitlab.ProtectedBranches("1", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(bs), 2)
+	assert.Equal(t, bs[0].PushAccessLevels[0].AccessLevel, MaintainerAccess)
+	assert.Equal(t, bs[1].PushAccessLevels[0].AccessLevel, NoAccess)
+	assert.Equal(t, bs[1].CodeOwnerApprovalRequired, true)
+}
+
+func TestProtectedBranch(t *testing.T) {
+	var requested string
+	stub, _ := ioutil.ReadFile("stubs/protected_branches/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.EscapedPath()
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	b, err := gitlab.ProtectedBranch("1", "release/*")
+
+	assert.NoError(t, err)
+	assert.Equal(t, requested, "/projects/1/protected_branches/release%2F%2A")
+	assert.Equal(t, b.Name, "release/*")
+	assert.Equal(t, b.UnprotectAccessLevels[0].AccessLevel, AdminAccess)
+	assert.Equal(t, b.AllowForcePush, true)
+}
+
+func TestProtectBranch(t *testing.T) {
+	ts, gitlab := Stub("stubs/protected_branches/show.json")
+	defer ts.Close()
+
+	b, err := gitlab.ProtectBranch("1", &ProtectBranchOpts{
+		Name:                      "release/*",
+		PushAccessLevel:           NoAccess.Ptr(),
+		MergeAccessLevel:          DeveloperAccess.Ptr(),
+		CodeOwnerApprovalRequired: true,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, b.MergeAccessLevels[0].AccessLevel, DeveloperAccess)
+
+	_, err = gitlab.ProtectBranch("1", &ProtectBranchOpts{Name: "master", PushAccessLevel: AccessLevel(10).Ptr()})
+	assert.Error(t, err)
+
+	_, err = gitlab.ProtectBranch("1", &ProtectBranchOpts{})
+	assert.Error(t, err)
+}
+
+func TestUpdateProtectedBranch(t *testing.T) {
+	ts, gitlab := Stub("stubs/protected_branches/show.json")
+	defer ts.Close()
+
+	force := true
+	b, err := gitlab.UpdateProtectedBranch("1", "release/*", &UpdateProtectedBranchOpts{AllowForcePush: &force})
+
+	assert.NoError(t, err)
+	assert.Equal(t, b.AllowForcePush, true)
+}
+
+func TestUnprotectBranch(t *testing.T) {
+	ts, gitlab := Stub("")
+	defer ts.Close()
+
+	err := gitlab.UnprotectBranch("1", "master")
+
+	assert.NoError(t, err)
+}
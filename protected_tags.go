@@ -0,0 +1,123 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+var (
+	protectedTagsUrl = path.Join(project_url, "protected_tags")
+	protectedTagUrl  = path.Join(project_url, "protected_tags", ":name")
+)
+
+type ProtectedTag struct {
+	Name               string                    `json:"name"`
+	CreateAccessLevels []*AccessLevelDescription `json:"create_access_levels"`
+}
+
+// Parameters of a tag protection, a nil access level is left to
+// the GitLab default (maintainers)
+type ProtectTagOpts struct {
+	Name              string       `json:"name"`
+	CreateAccessLevel *AccessLevel `json:"create_access_level,omitempty"`
+}
+
+func (opts *ProtectTagOpts) check() error {
+	if nil == opts || "" == opts.Name {
+		return fmt.Errorf("Missing tag name")
+	}
+
+	return checkAccessLevel("create_access_level", opts.CreateAccessLevel)
+}
+
+func (g *Gitlab) ProtectedTags(pid string, page *Pagination) ([]*ProtectedTag, error) {
+	query, err := paginationQuery(page)
+	if nil != err {
+		return nil, fmt.Errorf("Check list protected tags parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(protectedTagsUrl, map[string]string{":id": pid}, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list protected tags API error: %v", err)
+	}
+
+	var ts []*ProtectedTag
+	if err := json.Unmarshal(data, &ts); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return ts, nil
+}
+
+// Get a protected tag or wildcard, name may be a wildcard like `v*`
+func (g *Gitlab) ProtectedTag(pid, name string) (*ProtectedTag, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrl(protectedTagUrl, map[string]string{
+			":id":   pid,
+			":name": url.PathEscape(name),
+		}),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request get protected tag API error: %v", err)
+	}
+
+	var t *ProtectedTag
+	if err := json.Unmarshal(data, &t); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return t, nil
+}
+
+// Protect a single tag or several tags using a wildcard
+func (g *Gitlab) ProtectTag(pid string, opts *ProtectTagOpts) (*ProtectedTag, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check protect tag parameters error: %v", err)
+	}
+
+	body, err := json.Marshal(opts)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodPost,
+		g.ResourceUrl(protectedTagsUrl, map[string]string{":id": pid}),
+		body,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request protect tag API error: %v", err)
+	}
+
+	var t *ProtectedTag
+	if err := json.Unmarshal(data, &t); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return t, nil
+}
+
+func (g *Gitlab) UnprotectTag(pid, name string) error {
+	_, err := g.buildAndExecRequest(
+		http.MethodDelete,
+		g.ResourceUrl(protectedTagUrl, map[string]string{
+			":id":   pid,
+			":name": url.PathEscape(name),
+		}),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request unprotect tag API error: %v", err)
+	}
+
+	return err
+}
@@ -0,0 +1,40 @@
+package gogitlab
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtectedTags(t *testing.T) {
+	ts, gitlab := Stub("stubs/protected_tags/index.json")
+	defer ts.Close()
+
+	tags, err := gitlab.ProtectedTags("1", &Pagination{PerPage: 20})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(tags), 1)
+	assert.Equal(t, tags[0].CreateAccessLevels[0].AccessLevel, MaintainerAccess)
+}
+
+func TestProtectTag(t *testing.T) {
+	ts, gitlab := Stub("stubs/protected_tags/show.json")
+	defer ts.Close()
+
+	tag, err := gitlab.ProtectTag("1", &ProtectTagOpts{Name: "v*", CreateAccessLevel: NoAccess.Ptr()})
+
+	assert.NoError(t, err)
+	assert.Equal(t, tag.Name, "v*")
+
+	_, err = gitlab.ProtectTag("1", &ProtectTagOpts{})
+	assert.Error(t, err)
+}
+
+func TestUnprotectTag(t *testing.T) {
+	ts, gitlab := Stub("")
+	defer ts.Close()
+
+	err := gitlab.UnprotectTag("1", "v*")
+
+	assert.NoError(t, err)
+}
@@ -0,0 +1,140 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"time"
+)
+
+var (
+	pushRuleUrl = path.Join(project_url, "push_rule")
+)
+
+// The push rule of a project, empty regexes are not enforced
+type PushRule struct {
+	Id                         int    `json:"id"`
+	ProjectId                  int    `json:"project_id"`
+	CommitMessageRegex         string `json:"commit_message_regex"`
+	CommitMessageNegativeRegex string `json:"commit_message_negative_regex"`
+	BranchNameRegex            string `json:"branch_name_regex"`
+	AuthorEmailRegex           string `json:"author_email_regex"`
+	FileNameRegex              string `json:"file_name_regex"`
+	DenyDeleteTag              bool   `json:"deny_delete_tag"`
+	MemberCheck                bool   `json:"member_check"`
+	PreventSecrets             bool   `json:"prevent_secrets"`
+	CommitCommitterCheck       bool   `json:"commit_committer_check"`
+	RejectUnsignedCommits      bool   `json:"reject_unsigned_commits"`
+	// Maximum file size in MB, 0 means no limit
+	MaxFileSize int        `json:"max_file_size"`
+	CreatedAt   *time.Time `json:"created_at"`
+}
+
+// Parameters of a push rule, nil fields are left unchanged on edit.
+// Set a regex to "" to stop enforcing it.
+type PushRuleOpts struct {
+	CommitMessageRegex         *string `json:"commit_message_regex,omitempty"`
+	CommitMessageNegativeRegex *string `json:"commit_message_negative_regex,omitempty"`
+	BranchNameRegex            *string `json:"branch_name_regex,omitempty"`
+	AuthorEmailRegex           *string `json:"author_email_regex,omitempty"`
+	FileNameRegex              *string `json:"file_name_regex,omitempty"`
+	DenyDeleteTag              *bool   `json:"deny_delete_tag,omitempty"`
+	MemberCheck                *bool   `json:"member_check,omitempty"`
+	PreventSecrets             *bool   `json:"prevent_secrets,omitempty"`
+	CommitCommitterCheck       *bool   `json:"commit_committer_check,omitempty"`
+	RejectUnsignedCommits      *bool   `json:"reject_unsigned_commits,omitempty"`
+	MaxFileSize                *int    `json:"max_file_size,omitempty"`
+}
+
+func (opts *PushRuleOpts) check() error {
+	if nil == opts {
+		return fmt.Errorf("Missing push rule parameters")
+	}
+
+	// GitLab evaluates the regexes with RE2, as the regexp package does
+	regexes := []struct {
+		name string
+		re   *string
+	}{
+		{"commit_message_regex", opts.CommitMessageRegex},
+		{"commit_message_negative_regex", opts.CommitMessageNegativeRegex},
+		{"branch_name_regex", opts.BranchNameRegex},
+		{"author_email_regex", opts.AuthorEmailRegex},
+		{"file_name_regex", opts.FileNameRegex},
+	}
+	for _, r := range regexes {
+		if nil == r.re {
+			continue
+		}
+		if _, err := regexp.Compile(*r.re); nil != err {
+			return fmt.Errorf("Invalid %s '%s'", r.name, *r.re)
+		}
+	}
+
+	if nil != opts.MaxFileSize && *opts.MaxFileSize < 0 {
+		return fmt.Errorf("Invalid max_file_size '%d'", *opts.MaxFileSize)
+	}
+
+	return nil
+}
+
+func (g *Gitlab) execPushRule(method, pid string, opts *PushRuleOpts) (*PushRule, error) {
+	var body []byte
+	if nil != opts {
+		var err error
+		if body, err = json.Marshal(opts); nil != err {
+			return nil, fmt.Errorf("Encode request error: %v", err)
+		}
+	}
+
+	data, err := g.buildAndExecRequest(
+		method,
+		g.ResourceUrl(pushRuleUrl, map[string]string{":id": pid}),
+		body,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request push rule API error: %v", err)
+	}
+
+	var r *PushRule
+	if err := json.Unmarshal(data, &r); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return r, nil
+}
+
+// Get the push rule of a project, nil if the project has none
+func (g *Gitlab) ProjectPushRule(pid string) (*PushRule, error) {
+	return g.execPushRule(http.MethodGet, pid, nil)
+}
+
+// Add a push rule to a project, a project has at most one push rule
+func (g *Gitlab) AddProjectPushRule(pid string, opts *PushRuleOpts) (*PushRule, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check push rule parameters error: %v", err)
+	}
+	return g.execPushRule(http.MethodPost, pid, opts)
+}
+
+func (g *Gitlab) EditProjectPushRule(pid string, opts *PushRuleOpts) (*PushRule, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check push rule parameters error: %v", err)
+	}
+	return g.execPushRule(http.MethodPut, pid, opts)
+}
+
+func (g *Gitlab) DeleteProjectPushRule(pid string) error {
+	_, err := g.buildAndExecRequest(
+		http.MethodDelete,
+		g.ResourceUrl(pushRuleUrl, map[string]string{":id": pid}),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request delete push rule API error: %v", err)
+	}
+
+	return err
+}
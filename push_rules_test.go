@@ -0,0 +1,69 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectPushRule(t *testing.T) {
+	ts, gitlab := Stub("stubs/push_rules/show.json")
+	defer ts.Close()
+
+	rule, err := gitlab.ProjectPushRule("3")
+
+	assert.NoError(t, err)
+	assert.Equal(t, rule.ProjectId, 3)
+	assert.Equal(t, rule.CommitMessageRegex, "^(feat|fix|chore): ")
+	assert.Equal(t, rule.BranchNameRegex, "^(feature|hotfix)/")
+	assert.Equal(t, rule.DenyDeleteTag, true)
+	assert.Equal(t, rule.MemberCheck, true)
+	assert.Equal(t, rule.PreventSecrets, true)
+	assert.Equal(t, rule.MaxFileSize, 5)
+}
+
+func TestEditProjectPushRule(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/push_rules/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPut)
+		assert.Equal(t, r.URL.Path, "/projects/3/push_rule")
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, body, map[string]interface{}{
+			"file_name_regex": "",
+			"prevent_secrets": true,
+			"max_file_size":   float64(5),
+		})
+
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	noRegex, preventSecrets, maxFileSize := "", true, 5
+	rule, err := gitlab.EditProjectPushRule("3", &PushRuleOpts{
+		FileNameRegex:  &noRegex,
+		PreventSecrets: &preventSecrets,
+		MaxFileSize:    &maxFileSize,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, rule.Id, 1)
+}
+
+func TestAddProjectPushRuleCheck(t *testing.T) {
+	gitlab := NewGitlab("http://localhost", "", "")
+
+	invalid := "feat("
+	_, err := gitlab.AddProjectPushRule("3", &PushRuleOpts{BranchNameRegex: &invalid})
+	assert.EqualError(t, err, "Check push rule parameters error: Invalid branch_name_regex 'feat('")
+
+	negative := -1
+	_, err = gitlab.AddProjectPushRule("3", &PushRuleOpts{MaxFileSize: &negative})
+	assert.EqualError(t, err, "Check push rule parameters error: Invalid max_file_size '-1'")
+}
@@ -0,0 +1,347 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+)
+
+var (
+	releasesUrl     = path.Join(project_url, "releases")
+	releaseUrl      = path.Join(project_url, "releases", ":tag_name")
+	releaseLinksUrl = path.Join(project_url, "releases", ":tag_name", "assets", "links")
+	releaseLinkUrl  = path.Join(project_url, "releases", ":tag_name", "assets", "links", ":link_id")
+)
+
+const (
+	ReleaseLinkOther   = "other"
+	ReleaseLinkRunbook = "runbook"
+	ReleaseLinkImage   = "image"
+	ReleaseLinkPackage = "package"
+)
+
+type Release struct {
+	TagName         string        `json:"tag_name"`
+	Name            string        `json:"name"`
+	Description     string        `json:"description"`
+	DescriptionHTML string        `json:"description_html,omitempty"`
+	CreatedAt       *time.Time    `json:"created_at"`
+	ReleasedAt      *time.Time    `json:"released_at"`
+	UpcomingRelease bool          `json:"upcoming_release"`
+	Author          *User         `json:"author,omitempty"`
+	Commit          *Commit       `json:"commit,omitempty"`
+	Milestones      []*Milestone  `json:"milestones,omitempty"`
+	CommitPath      string        `json:"commit_path,omitempty"`
+	TagPath         string        `json:"tag_path,omitempty"`
+	Assets          ReleaseAssets `json:"assets"`
+}
+
+type ReleaseAssets struct {
+	Count   int              `json:"count"`
+	Sources []*ReleaseSource `json:"sources"`
+	Links   []*ReleaseLink   `json:"links"`
+}
+
+// An archive of the release sources generated by GitLab
+type ReleaseSource struct {
+	Format string `json:"format"`
+	URL    string `json:"url"`
+}
+
+type ReleaseLink struct {
+	Id             int    `json:"id"`
+	Name           string `json:"name"`
+	URL            string `json:"url"`
+	DirectAssetURL string `json:"direct_asset_url,omitempty"`
+	LinkType       string `json:"link_type,omitempty"`
+	External       bool   `json:"external"`
+}
+
+// Parameters of an asset link, empty fields are left unchanged on update
+type ReleaseLinkOpts struct {
+	Name     string `json:"name,omitempty"`
+	URL      string `json:"url,omitempty"`
+	FilePath string `json:"filepath,omitempty"`
+	LinkType string `json:"link_type,omitempty"`
+}
+
+type CreateReleaseOpts struct {
+	TagName     string `json:"tag_name"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Ref the tag is created from when it does not exist yet
+	Ref string `json:"ref,omitempty"`
+	// Annotation message of the tag created from Ref
+	TagMessage string `json:"tag_message,omitempty"`
+	// Titles of the milestones the release is associated with
+	Milestones []string             `json:"milestones,omitempty"`
+	Assets     *CreateReleaseAssets `json:"assets,omitempty"`
+	ReleasedAt *time.Time           `json:"released_at,omitempty"`
+}
+
+type CreateReleaseAssets struct {
+	Links []*ReleaseLinkOpts `json:"links"`
+}
+
+// Parameters of a release update, empty fields are left unchanged
+type UpdateReleaseOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Replaces the milestones, point to an empty slice to detach them all
+	Milestones *[]string  `json:"milestones,omitempty"`
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+}
+
+var validReleaseLinkType = map[string]bool{
+	ReleaseLinkOther:   true,
+	ReleaseLinkRunbook: true,
+	ReleaseLinkImage:   true,
+	ReleaseLinkPackage: true,
+}
+
+func (opts *ReleaseLinkOpts) check() error {
+	if nil == opts {
+		return fmt.Errorf("Missing asset link")
+	}
+
+	if "" != opts.LinkType && !validReleaseLinkType[opts.LinkType] {
+		return fmt.Errorf("Invalid link_type '%s'", opts.LinkType)
+	}
+
+	return nil
+}
+
+func (opts *CreateReleaseOpts) check() error {
+	if nil == opts || "" == opts.TagName {
+		return fmt.Errorf("Missing tag_name")
+	}
+
+	if nil == opts.Assets {
+		return nil
+	}
+
+	for _, l := range opts.Assets.Links {
+		if err := l.check(); nil != err {
+			return err
+		}
+		if "" == l.Name || "" == l.URL {
+			return fmt.Errorf("Missing asset link name or url")
+		}
+	}
+
+	return nil
+}
+
+func (g *Gitlab) ListReleases(pid string, page *Pagination) ([]*Release, error) {
+	query, err := paginationQuery(page)
+	if nil != err {
+		return nil, fmt.Errorf("Check list releases parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(releasesUrl, map[string]string{":id": pid}, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list releases API error: %v", err)
+	}
+
+	var rs []*Release
+	if err := json.Unmarshal(data, &rs); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return rs, nil
+}
+
+// Get the release of a tag
+func (g *Gitlab) Release(pid, tagName string) (*Release, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrl(releaseUrl, map[string]string{
+			":id":       pid,
+			":tag_name": url.PathEscape(tagName),
+		}),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request get release API error: %v", err)
+	}
+
+	var r *Release
+	if err := json.Unmarshal(data, &r); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return r, nil
+}
+
+// Create a release, the tag is created from opts.Ref if it does not exist
+func (g *Gitlab) CreateRelease(pid string, opts *CreateReleaseOpts) (*Release, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check create release parameters error: %v", err)
+	}
+
+	body, err := json.Marshal(opts)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodPost,
+		g.ResourceUrl(releasesUrl, map[string]string{":id": pid}),
+		body,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request create release API error: %v", err)
+	}
+
+	var r *Release
+	if err := json.Unmarshal(data, &r); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return r, nil
+}
+
+func (g *Gitlab) UpdateRelease(pid, tagName string, opts *UpdateReleaseOpts) (*Release, error) {
+	body, err := json.Marshal(opts)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodPut,
+		g.ResourceUrl(releaseUrl, map[string]string{
+			":id":       pid,
+			":tag_name": url.PathEscape(tagName),
+		}),
+		body,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request update release API error: %v", err)
+	}
+
+	var r *Release
+	if err := json.Unmarshal(data, &r); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return r, nil
+}
+
+// Delete a release, the associated tag is kept
+func (g *Gitlab) DeleteRelease(pid, tagName string) error {
+	_, err := g.buildAndExecRequest(
+		http.MethodDelete,
+		g.ResourceUrl(releaseUrl, map[string]string{
+			":id":       pid,
+			":tag_name": url.PathEscape(tagName),
+		}),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request delete release API error: %v", err)
+	}
+
+	return err
+}
+
+func (g *Gitlab) ReleaseLinks(pid, tagName string) ([]*ReleaseLink, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrl(releaseLinksUrl, map[string]string{
+			":id":       pid,
+			":tag_name": url.PathEscape(tagName),
+		}),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list release links API error: %v", err)
+	}
+
+	var ls []*ReleaseLink
+	if err := json.Unmarshal(data, &ls); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return ls, nil
+}
+
+func (g *Gitlab) ReleaseLink(pid, tagName string, linkId int) (*ReleaseLink, error) {
+	return g.execReleaseLink(http.MethodGet, pid, tagName, linkId, nil)
+}
+
+func (g *Gitlab) CreateReleaseLink(pid, tagName string, opts *ReleaseLinkOpts) (*ReleaseLink, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check release link parameters error: %v", err)
+	}
+
+	body, err := json.Marshal(opts)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodPost,
+		g.ResourceUrl(releaseLinksUrl, map[string]string{
+			":id":       pid,
+			":tag_name": url.PathEscape(tagName),
+		}),
+		body,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request create release link API error: %v", err)
+	}
+
+	var l *ReleaseLink
+	if err := json.Unmarshal(data, &l); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return l, nil
+}
+
+func (g *Gitlab) UpdateReleaseLink(pid, tagName string, linkId int, opts *ReleaseLinkOpts) (*ReleaseLink, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check release link parameters error: %v", err)
+	}
+
+	body, err := json.Marshal(opts)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	return g.execReleaseLink(http.MethodPut, pid, tagName, linkId, body)
+}
+
+func (g *Gitlab) DeleteReleaseLink(pid, tagName string, linkId int) (*ReleaseLink, error) {
+	return g.execReleaseLink(http.MethodDelete, pid, tagName, linkId, nil)
+}
+
+func (g *Gitlab) execReleaseLink(method, pid, tagName string, linkId int, body []byte) (*ReleaseLink, error) {
+	data, err := g.buildAndExecRequest(
+		method,
+		g.ResourceUrl(releaseLinkUrl, map[string]string{
+			":id":       pid,
+			":tag_name": url.PathEscape(tagName),
+			":link_id":  strconv.Itoa(linkId),
+		}),
+		body,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request release link API error: %v", err)
+	}
+
+	var l *ReleaseLink
+	if err := json.Unmarshal(data, &l); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return l, nil
+}
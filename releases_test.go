@@ -0,0 +1,132 @@
+package gogitlab
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListReleases(t *testing.T) {
+	ts, gitlab := Stub("stubs/releases/index.json")
+	defer ts.Close()
+
+	rs, err := gitlab.ListReleases("1", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(rs), 2)
+	assert.Equal(t, rs[0].TagName, "v0.2")
+	assert.Equal(t, len(rs[1].Milestones), 1)
+}
+
+func TestRelease(t *testing.T) {
+	ts, gitlab := Stub("stubs/releases/show.json")
+	defer ts.Close()
+
+	r, err := gitlab.Release("1", "v0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, r.Name, "Awesome app v0.1 alpha")
+	assert.Equal(t, r.Author.Username, "root")
+	assert.Equal(t, r.Commit.Id, "f8d3d94cbd347e924aa7b715845e439d00e80ca4")
+	assert.Equal(t, r.Milestones[0].Title, "v1.0-rc")
+	assert.Equal(t, r.Assets.Count, 3)
+	assert.Equal(t, len(r.Assets.Sources), 2)
+	assert.Equal(t, r.Assets.Links[0].LinkType, ReleaseLinkPackage)
+	assert.Equal(t, r.ReleasedAt.Year(), 2019)
+}
+
+func TestCreateRelease(t *testing.T) {
+	ts, gitlab := Stub("stubs/releases/show.json")
+	defer ts.Close()
+
+	r, err := gitlab.CreateRelease("1", &CreateReleaseOpts{
+		TagName:     "v0.1",
+		Ref:         "master",
+		Description: "## CHANGELOG",
+		Milestones:  []string{"v1.0-rc"},
+		Assets: &CreateReleaseAssets{
+			Links: []*ReleaseLinkOpts{
+				{Name: "awesome-v0.1.dmg", URL: "https://gitlab.example.com/awesome-v0.1.dmg", LinkType: ReleaseLinkPackage},
+			},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, r.TagName, "v0.1")
+
+	_, err = gitlab.CreateRelease("1", &CreateReleaseOpts{})
+	assert.Error(t, err)
+
+	_, err = gitlab.CreateRelease("1", &CreateReleaseOpts{
+		TagName: "v0.1",
+		Assets:  &CreateReleaseAssets{Links: []*ReleaseLinkOpts{{Name: "bin", URL: "http://x", LinkType: "binary"}}},
+	})
+	assert.Error(t, err)
+}
+
+func TestUpdateRelease(t *testing.T) {
+	ts, gitlab := Stub("stubs/releases/show.json")
+	defer ts.Close()
+
+	r, err := gitlab.UpdateRelease("1", "v0.1", &UpdateReleaseOpts{Name: "Awesome app v0.1 alpha"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, r.Name, "Awesome app v0.1 alpha")
+}
+
+func TestUpdateReleaseMilestones(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/releases/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.Method, http.MethodPut)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, string(body), `{"milestones":[]}`)
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	_, err := gitlab.UpdateRelease("1", "v0.1", &UpdateReleaseOpts{Milestones: &[]string{}})
+
+	assert.NoError(t, err)
+}
+
+func TestDeleteRelease(t *testing.T) {
+	ts, gitlab := Stub("stubs/releases/show.json")
+	defer ts.Close()
+
+	assert.NoError(t, gitlab.DeleteRelease("1", "v0.1"))
+}
+
+func TestReleaseLinks(t *testing.T) {
+	ts, gitlab := Stub("stubs/releases/links.json")
+	defer ts.Close()
+
+	ls, err := gitlab.ReleaseLinks("1", "v0.1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(ls), 1)
+	assert.Equal(t, ls[0].Name, "awesome-v0.1.dmg")
+}
+
+func TestCreateReleaseLink(t *testing.T) {
+	ts, gitlab := Stub("stubs/releases/link.json")
+	defer ts.Close()
+
+	l, err := gitlab.CreateReleaseLink("1", "v0.1", &ReleaseLinkOpts{
+		Name:     "awesome-v0.1.dmg",
+		URL:      "https://gitlab.example.com/root/awesome-app/-/jobs/688/artifacts/raw/awesome-v0.1.dmg",
+		FilePath: "/awesome-v0.1.dmg",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, l.Id, 1)
+	assert.Equal(t, l.DirectAssetURL, "https://gitlab.example.com/root/awesome-app/-/releases/v0.1/downloads/awesome-v0.1.dmg")
+
+	l, err = gitlab.DeleteReleaseLink("1", "v0.1", 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, l.Id, 1)
+}
@@ -0,0 +1,144 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"time"
+)
+
+var (
+	remoteMirrorsUrl = path.Join(project_url, "remote_mirrors")
+	remoteMirrorUrl  = path.Join(project_url, "remote_mirrors", ":mirror_id")
+	pullMirrorUrl    = path.Join(project_url, "mirror", "pull")
+)
+
+// A push mirror, the project is pushed to URL after every change
+type RemoteMirror struct {
+	Id      int    `json:"id"`
+	Enabled bool   `json:"enabled"`
+	URL     string `json:"url"` // Credentials are masked
+	// One of none, scheduled, started, finished, failed
+	UpdateStatus           string     `json:"update_status"`
+	LastError              string     `json:"last_error"`
+	LastUpdateAt           *time.Time `json:"last_update_at"`
+	LastUpdateStartedAt    *time.Time `json:"last_update_started_at"`
+	LastSuccessfulUpdateAt *time.Time `json:"last_successful_update_at"`
+	OnlyProtectedBranches  bool       `json:"only_protected_branches"`
+	KeepDivergentRefs      bool       `json:"keep_divergent_refs"`
+	MirrorBranchRegex      string     `json:"mirror_branch_regex"`
+	AuthMethod             string     `json:"auth_method"`
+}
+
+// Parameters of a push mirror, nil fields are left unchanged on update
+type RemoteMirrorOpts struct {
+	// Only used on creation, credentials may be given in the URL
+	URL                   string  `json:"url,omitempty"`
+	Enabled               *bool   `json:"enabled,omitempty"`
+	OnlyProtectedBranches *bool   `json:"only_protected_branches,omitempty"`
+	KeepDivergentRefs     *bool   `json:"keep_divergent_refs,omitempty"`
+	MirrorBranchRegex     *string `json:"mirror_branch_regex,omitempty"`
+	// Either password or ssh_public_key
+	AuthMethod string `json:"auth_method,omitempty"`
+}
+
+func (g *Gitlab) execRemoteMirror(method, u string, params map[string]string, opts *RemoteMirrorOpts) (*RemoteMirror, error) {
+	var body []byte
+	if nil != opts {
+		var err error
+		if body, err = json.Marshal(opts); nil != err {
+			return nil, fmt.Errorf("Encode request error: %v", err)
+		}
+	}
+
+	data, err := g.buildAndExecRequest(method, g.ResourceUrl(u, params), body)
+	if nil != err {
+		return nil, fmt.Errorf("Request remote mirror API error: %v", err)
+	}
+
+	var m *RemoteMirror
+	if err := json.Unmarshal(data, &m); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return m, nil
+}
+
+func remoteMirrorParams(pid string, mirrorId int) map[string]string {
+	return map[string]string{":id": pid, ":mirror_id": strconv.Itoa(mirrorId)}
+}
+
+// List the push mirrors of a project
+func (g *Gitlab) RemoteMirrors(pid string, page *Pagination) ([]*RemoteMirror, error) {
+	query, err := paginationQuery(page)
+	if nil != err {
+		return nil, fmt.Errorf("Check list remote mirrors parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(remoteMirrorsUrl, map[string]string{":id": pid}, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list remote mirrors API error: %v", err)
+	}
+
+	var ms []*RemoteMirror
+	if err := json.Unmarshal(data, &ms); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return ms, nil
+}
+
+func (g *Gitlab) RemoteMirror(pid string, mirrorId int) (*RemoteMirror, error) {
+	return g.execRemoteMirror(http.MethodGet, remoteMirrorUrl, remoteMirrorParams(pid, mirrorId), nil)
+}
+
+func (g *Gitlab) CreateRemoteMirror(pid string, opts *RemoteMirrorOpts) (*RemoteMirror, error) {
+	if nil == opts || "" == opts.URL {
+		return nil, fmt.Errorf("Check create remote mirror parameters error: Missing url")
+	}
+	return g.execRemoteMirror(http.MethodPost, remoteMirrorsUrl, map[string]string{":id": pid}, opts)
+}
+
+// Update the settings of a push mirror, its URL can not be changed
+func (g *Gitlab) UpdateRemoteMirror(pid string, mirrorId int, opts *RemoteMirrorOpts) (*RemoteMirror, error) {
+	if nil == opts {
+		return nil, fmt.Errorf("Check update remote mirror parameters error: Missing parameters")
+	}
+	if "" != opts.URL {
+		return nil, fmt.Errorf("Check update remote mirror parameters error: url can not be updated")
+	}
+	return g.execRemoteMirror(http.MethodPut, remoteMirrorUrl, remoteMirrorParams(pid, mirrorId), opts)
+}
+
+func (g *Gitlab) DeleteRemoteMirror(pid string, mirrorId int) error {
+	_, err := g.buildAndExecRequest(
+		http.MethodDelete,
+		g.ResourceUrl(remoteMirrorUrl, remoteMirrorParams(pid, mirrorId)),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request delete remote mirror API error: %v", err)
+	}
+
+	return err
+}
+
+// Start the update of a pull mirror project from its upstream repository
+func (g *Gitlab) TriggerPullMirror(pid string) error {
+	_, err := g.buildAndExecRequest(
+		http.MethodPost,
+		g.ResourceUrl(pullMirrorUrl, map[string]string{":id": pid}),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request trigger pull mirror API error: %v", err)
+	}
+
+	return err
+}
@@ -2,23 +2,33 @@ package gogitlab
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
 	"time"
 )
 
 const (
-	repo_url_branches = "/projects/:id/repository/branches"         // List repository branches
-	repo_url_branch   = "/projects/:id/repository/branches/:branch" // Get a specific branch of a project.
-	repo_url_tags     = "/projects/:id/repository/tags"             // List project repository tags
-	repo_url_commits  = "/projects/:id/repository/commits"          // List repository commits
-	repo_url_tree     = "/projects/:id/repository/tree"             // List repository tree
-	repo_url_raw_file = "/projects/:id/repository/blobs/:sha"       // Get raw file content for specific commit/branch
+	repo_url_branches        = "/projects/:id/repository/branches"         // List repository branches
+	repo_url_branch          = "/projects/:id/repository/branches/:branch" // Get a specific branch of a project.
+	repo_url_merged_branches = "/projects/:id/repository/merged_branches"  // Delete branches merged into the default branch
+	repo_url_tags            = "/projects/:id/repository/tags"             // List project repository tags
+	repo_url_tag             = "/projects/:id/repository/tags/:tag_name"   // Get a specific repository tag
+	repo_url_commits         = "/projects/:id/repository/commits"          // List repository commits
+	repo_url_tree            = "/projects/:id/repository/tree"             // List repository tree
+	repo_url_raw_file        = "/projects/:id/repository/blobs/:sha"       // Get raw file content for specific commit/branch
+	repo_url_compare         = "/projects/:id/repository/compare"          // Compare branches, tags or commits
+	repo_url_merge_base      = "/projects/:id/repository/merge_base"       // Get the common ancestor of several refs
+	repo_url_contributors    = "/projects/:id/repository/contributors"     // Get repository contributors list
 )
 
 type TreeNode struct {
-	Name string
-	Type string
-	Mode string
-	Id   string
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Mode string `json:"mode"`
+	Id   string `json:"id"`
+	// Path of the node from the repository root
+	Path string `json:"path"`
 }
 
 type BranchCommit struct {
@@ -38,26 +48,96 @@ type BranchCommit struct {
 }
 
 type Branch struct {
-	Name      string        `json:"name,omitempty"`
-	Protected bool          `json:"protected,omitempty"`
-	Commit    *BranchCommit `json:"commit,omitempty"`
+	Name               string        `json:"name,omitempty"`
+	Protected          bool          `json:"protected,omitempty"`
+	Merged             bool          `json:"merged,omitempty"`
+	Default            bool          `json:"default,omitempty"`
+	DevelopersCanPush  bool          `json:"developers_can_push,omitempty"`
+	DevelopersCanMerge bool          `json:"developers_can_merge,omitempty"`
+	CanPush            bool          `json:"can_push,omitempty"`
+	WebURL             string        `json:"web_url,omitempty"`
+	Commit             *BranchCommit `json:"commit,omitempty"`
+}
+
+type ListBranchesOpts struct {
+	// Return branches containing this string, `^term` and `term$`
+	// match names beginning and ending with term
+	Search string
+	Pagination
 }
 
 type Tag struct {
 	Name      string        `json:"name,omitempty"`
+	Message   string        `json:"message,omitempty"`
+	Target    string        `json:"target,omitempty"`
 	Protected bool          `json:"protected,omitempty"`
 	Commit    *BranchCommit `json:"commit,omitempty"`
+	Release   *TagRelease   `json:"release,omitempty"`
+}
+
+// Release notes attached to a tag
+type TagRelease struct {
+	TagName     string `json:"tag_name"`
+	Description string `json:"description"`
 }
 
 type Commit struct {
-	Id           string
-	Short_Id     string
-	Title        string
-	Author_Name  string
-	Author_Email string
-	Created_At   string
-	CreatedAt    time.Time
-	Message      string
+	Id             string         `json:"id"`
+	ShortId        string         `json:"short_id"`
+	Title          string         `json:"title"`
+	Message        string         `json:"message"`
+	AuthorName     string         `json:"author_name"`
+	AuthorEmail    string         `json:"author_email"`
+	AuthoredDate   *time.Time     `json:"authored_date,omitempty"`
+	CommitterName  string         `json:"committer_name,omitempty"`
+	CommitterEmail string         `json:"committer_email,omitempty"`
+	CommittedDate  *time.Time     `json:"committed_date,omitempty"`
+	CreatedAt      time.Time      `json:"created_at"`
+	ParentIds      []string       `json:"parent_ids,omitempty"`
+	WebURL         string         `json:"web_url,omitempty"`
+	Stats          *CommitStats   `json:"stats,omitempty"`
+	Status         string         `json:"status,omitempty"`
+	LastPipeline   *PipelineBrief `json:"last_pipeline,omitempty"`
+}
+
+type CommitStats struct {
+	Additions int `json:"additions"`
+	Deletions int `json:"deletions"`
+	Total     int `json:"total"`
+}
+
+type ListCommitsOpts struct {
+	// Branch, tag or range (`from..to`) of commits, the default branch if empty
+	RefName string
+	// Only commits touching this file path
+	Path  string
+	Since *time.Time
+	Until *time.Time
+	// Every commit of the repository, RefName is ignored
+	All       bool
+	WithStats bool
+	// Only the first parent of merge commits
+	FirstParent bool
+	Pagination
+}
+
+// Result of the comparison of two refs
+type Compare struct {
+	// Last commit of the comparison
+	Commit         *Commit       `json:"commit"`
+	Commits        []*Commit     `json:"commits"`
+	Diffs          []*ChangeItem `json:"diffs"`
+	CompareTimeout bool          `json:"compare_timeout"`
+	CompareSameRef bool          `json:"compare_same_ref"`
+	WebURL         string        `json:"web_url,omitempty"`
+}
+
+type Contributor struct {
+	Name      string `json:"name"`
+	Email     string `json:"email"`
+	Commits   int    `json:"commits"`
+	Additions int    `json:"additions"`
+	Deletions int    `json:"deletions"`
 }
 
 /*
@@ -72,22 +152,11 @@ Parameters:
 		ref_name (optional) The name of a repository branch or tag or if not given the default branch
 
 Usage:
-		pass nil when not using optional parameters
+		pass "" when not using optional parameters, use ListTree for
+		recursive or paginated listings
 */
 func (g *Gitlab) RepoTree(id, path, ref_name string) ([]*TreeNode, error) {
-
-	url, opaque := g.ResourceUrlRaw(repo_url_tree, map[string]string{":id": id})
-	url += "&path=" + path
-	url += "&ref_name=" + ref_name
-
-	var treeNodes []*TreeNode
-
-	contents, err := g.buildAndExecRequestRaw("GET", url, opaque, nil)
-	if err == nil {
-		err = json.Unmarshal(contents, &treeNodes)
-	}
-
-	return treeNodes, err
+	return g.ListTree(id, &ListTreeOpts{Path: path, Ref: ref_name})
 }
 
 /*
@@ -123,6 +192,47 @@ func (g *Gitlab) RepoBranches(id string) ([]*Branch, error) {
 	return branches, err
 }
 
+func (opts *ListBranchesOpts) toQuery() (map[string]string, error) {
+	if nil == opts {
+		return nil, nil
+	}
+
+	if err := opts.Pagination.check(); nil != err {
+		return nil, err
+	}
+
+	query := make(map[string]string)
+	if "" != opts.Search {
+		query["search"] = opts.Search
+	}
+	opts.Pagination.toQuery(query)
+	return query, nil
+}
+
+// Get a filtered and paginated list of repository branches from a project
+func (g *Gitlab) ListBranches(id string, opts *ListBranchesOpts) ([]*Branch, error) {
+	query, err := opts.toQuery()
+	if nil != err {
+		return nil, fmt.Errorf("Check list branches parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(repo_url_branches, map[string]string{":id": id}, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list branches API error: %v", err)
+	}
+
+	var branches []*Branch
+	if err := json.Unmarshal(data, &branches); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return branches, nil
+}
+
 /*
 Get a single project repository branch.
 
@@ -150,6 +260,92 @@ func (g *Gitlab) RepoBranch(id, refName string) (*Branch, error) {
 	return branch, err
 }
 
+/*
+Create a new branch in the repository.
+
+    POST /projects/:id/repository/branches
+
+Parameters:
+
+    id     The ID of a project
+    branch The name of the branch
+    ref    The branch name or commit SHA to create the branch from
+
+*/
+func (g *Gitlab) CreateBranch(id, branch, ref string) (*Branch, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodPost,
+		g.ResourceUrlWithQuery(
+			repo_url_branches,
+			map[string]string{":id": id},
+			map[string]string{"branch": branch, "ref": ref},
+		),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request create branch API error: %v", err)
+	}
+
+	var b *Branch
+	if err := json.Unmarshal(data, &b); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return b, nil
+}
+
+/*
+Delete a branch from the repository.
+
+    DELETE /projects/:id/repository/branches/:branch
+
+Parameters:
+
+    id     The ID of a project
+    branch The name of the branch
+
+*/
+func (g *Gitlab) DeleteBranch(id, branch string) error {
+	_, err := g.buildAndExecRequest(
+		http.MethodDelete,
+		g.ResourceUrl(repo_url_branch, map[string]string{
+			":id":     id,
+			":branch": url.PathEscape(branch),
+		}),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request delete branch API error: %v", err)
+	}
+
+	return err
+}
+
+/*
+Delete all branches that are merged into the project default branch.
+Protected branches are not deleted. The deletion is done asynchronously
+by GitLab.
+
+    DELETE /projects/:id/repository/merged_branches
+
+Parameters:
+
+    id The ID of a project
+
+*/
+func (g *Gitlab) DeleteMergedBranches(id string) error {
+	_, err := g.buildAndExecRequest(
+		http.MethodDelete,
+		g.ResourceUrl(repo_url_merged_branches, map[string]string{":id": id}),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request delete merged branches API error: %v", err)
+	}
+
+	return err
+}
+
 /*
 Get a list of repository tags from a project, sorted by name in reverse alphabetical order.
 
@@ -183,6 +379,120 @@ func (g *Gitlab) RepoTags(id string) ([]*Tag, error) {
 	return tags, err
 }
 
+/*
+Create a new tag in the repository, an annotated tag is created
+when message is not empty.
+
+    POST /projects/:id/repository/tags
+
+Parameters:
+
+    id      The ID of a project
+    tagName The name of the tag
+    ref     The branch name or commit SHA to create the tag from
+    message The annotation message of the tag
+
+*/
+func (g *Gitlab) CreateTag(id, tagName, ref, message string) (*Tag, error) {
+	query := map[string]string{
+		"tag_name": tagName,
+		"ref":      ref,
+	}
+	if "" != message {
+		query["message"] = message
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodPost,
+		g.ResourceUrlWithQuery(repo_url_tags, map[string]string{":id": id}, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request create tag API error: %v", err)
+	}
+
+	var t *Tag
+	if err := json.Unmarshal(data, &t); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return t, nil
+}
+
+/*
+Delete a tag from the repository.
+
+    DELETE /projects/:id/repository/tags/:tag_name
+
+Parameters:
+
+    id      The ID of a project
+    tagName The name of the tag
+
+*/
+func (g *Gitlab) DeleteTag(id, tagName string) error {
+	_, err := g.buildAndExecRequest(
+		http.MethodDelete,
+		g.ResourceUrl(repo_url_tag, map[string]string{
+			":id":       id,
+			":tag_name": url.PathEscape(tagName),
+		}),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request delete tag API error: %v", err)
+	}
+
+	return err
+}
+
+func (opts *ListCommitsOpts) toQuery() (map[string]string, error) {
+	if nil == opts {
+		return nil, nil
+	}
+
+	if err := opts.check(); nil != err {
+		return nil, err
+	}
+
+	query := make(map[string]string)
+	if "" != opts.RefName {
+		query["ref_name"] = opts.RefName
+	}
+	if "" != opts.Path {
+		query["path"] = opts.Path
+	}
+	if nil != opts.Since {
+		query["since"] = opts.Since.Format(time.RFC3339)
+	}
+	if nil != opts.Until {
+		query["until"] = opts.Until.Format(time.RFC3339)
+	}
+	if opts.All {
+		query["all"] = "true"
+	}
+	if opts.WithStats {
+		query["with_stats"] = "true"
+	}
+	if opts.FirstParent {
+		query["first_parent"] = "true"
+	}
+	opts.Pagination.toQuery(query)
+	return query, nil
+}
+
+func (opts *ListCommitsOpts) check() error {
+	if nil == opts {
+		return nil
+	}
+
+	if nil != opts.Since && nil != opts.Until && opts.Until.Before(*opts.Since) {
+		return fmt.Errorf("Invalid until '%s' before since '%s'", opts.Until.Format(time.RFC3339), opts.Since.Format(time.RFC3339))
+	}
+
+	return opts.Pagination.check()
+}
+
 /*
 Get a list of repository commits in a project.
 
@@ -191,7 +501,6 @@ Get a list of repository commits in a project.
 Parameters:
 
     id      The ID of a project
-	refName The name of a repository branch or tag or if not given the default branch
 
 Usage:
 
@@ -204,23 +513,162 @@ Usage:
 	}
 */
 func (g *Gitlab) RepoCommits(id string) ([]*Commit, error) {
+	return g.ListCommits(id, nil)
+}
+
+/*
+Get a filtered and paginated list of repository commits in a project.
+
+    GET /projects/:id/repository/commits
+
+Parameters:
+
+    id   The ID of a project
+    opts The filters of the listing, nil to list the default branch commits
+
+Usage:
+
+	since := time.Now().AddDate(0, -1, 0)
+	commits, err := gitlab.ListCommits("your_projet_id", &ListCommitsOpts{
+		RefName: "master",
+		Path:    "README.md",
+		Since:   &since,
+	})
+*/
+func (g *Gitlab) ListCommits(id string, opts *ListCommitsOpts) ([]*Commit, error) {
+	query, err := opts.toQuery()
+	if nil != err {
+		return nil, fmt.Errorf("Check list commits parameters error: %v", err)
+	}
 
-	url, opaque := g.ResourceUrlRaw(repo_url_commits, map[string]string{":id": id})
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(repo_url_commits, map[string]string{":id": id}, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list commits API error: %v", err)
+	}
 
 	var commits []*Commit
+	if err := json.Unmarshal(data, &commits); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
 
-	contents, err := g.buildAndExecRequestRaw("GET", url, opaque, nil)
-	if err == nil {
-		err = json.Unmarshal(contents, &commits)
-		if err == nil {
-			for _, commit := range commits {
-				t, _ := time.Parse(dateLayout, commit.Created_At)
-				commit.CreatedAt = t
-			}
-		}
+	return commits, nil
+}
+
+/*
+Compare branches, tags or commits.
+
+    GET /projects/:id/repository/compare
+
+Parameters:
+
+    id       The ID of a project
+    from     The commit SHA or branch name to compare from
+    to       The commit SHA or branch name to compare to
+    straight Compare from and to directly (`from..to`) instead of
+             using their merge base (`from...to`)
+
+*/
+func (g *Gitlab) CompareRefs(id, from, to string, straight bool) (*Compare, error) {
+	query := map[string]string{
+		"from": from,
+		"to":   to,
+	}
+	if straight {
+		query["straight"] = "true"
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(repo_url_compare, map[string]string{":id": id}, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request compare API error: %v", err)
+	}
+
+	var c *Compare
+	if err := json.Unmarshal(data, &c); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
 	}
 
-	return commits, err
+	return c, nil
+}
+
+/*
+Get the common ancestor of two or more refs.
+
+    GET /projects/:id/repository/merge_base
+
+Parameters:
+
+    id   The ID of a project
+    refs The commit SHAs or branch names to find the merge base of
+
+*/
+func (g *Gitlab) MergeBase(id string, refs ...string) (*Commit, error) {
+	if len(refs) < 2 {
+		return nil, fmt.Errorf("Check merge base parameters error: At least 2 refs are required")
+	}
+
+	query := url.Values{"refs[]": refs}
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQueryValues(repo_url_merge_base, map[string]string{":id": id}, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request merge base API error: %v", err)
+	}
+
+	var c *Commit
+	if err := json.Unmarshal(data, &c); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return c, nil
+}
+
+/*
+Get all the repository contributors, following pagination.
+
+    GET /projects/:id/repository/contributors
+
+Parameters:
+
+    id The ID of a project
+
+*/
+func (g *Gitlab) Contributors(id string) ([]*Contributor, error) {
+	var all []*Contributor
+	page := Pagination{Page: 1, PerPage: 100}
+	for {
+		query := make(map[string]string)
+		page.toQuery(query)
+
+		data, err := g.buildAndExecRequest(
+			http.MethodGet,
+			g.ResourceUrlWithQuery(repo_url_contributors, map[string]string{":id": id}, query),
+			nil,
+		)
+		if nil != err {
+			return nil, fmt.Errorf("Request contributors API error: %v", err)
+		}
+
+		var cs []*Contributor
+		if err := json.Unmarshal(data, &cs); nil != err {
+			return nil, fmt.Errorf("Decode response error: %v", err)
+		}
+
+		all = append(all, cs...)
+		if len(cs) < page.PerPage {
+			return all, nil
+		}
+		page.Page++
+	}
 }
 
 /*
@@ -228,13 +676,13 @@ Get Raw file content
 */
 func (g *Gitlab) RepoRawFile(id, sha, filepath string) ([]byte, error) {
 
-	url, opaque := g.ResourceUrlRaw(repo_url_raw_file, map[string]string{
+	u, opaque := g.ResourceUrlRaw(repo_url_raw_file, map[string]string{
 		":id":  id,
 		":sha": sha,
 	})
-	url += "&filepath=" + filepath
+	u += "?" + url.Values{"filepath": {filepath}}.Encode()
 
-	contents, err := g.buildAndExecRequestRaw("GET", url, opaque, nil)
+	contents, err := g.buildAndExecRequestRaw("GET", u, opaque, nil)
 
 	return contents, err
 }
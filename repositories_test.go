@@ -2,7 +2,12 @@ package gogitlab
 
 import (
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 )
 
 func TestRepoBranches(t *testing.T) {
@@ -24,6 +29,40 @@ func TestRepoBranch(t *testing.T) {
 	defer ts.Close()
 }
 
+func TestListBranches(t *testing.T) {
+	ts, gitlab := Stub("stubs/branches/index.json")
+	defer ts.Close()
+
+	branches, err := gitlab.ListBranches("1", &ListBranchesOpts{Search: "^mas"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(branches), 1)
+	assert.Equal(t, branches[0].Name, "master")
+
+	_, err = gitlab.ListBranches("1", &ListBranchesOpts{Pagination: Pagination{PerPage: 1000}})
+	assert.Error(t, err)
+}
+
+func TestCreateBranch(t *testing.T) {
+	ts, gitlab := Stub("stubs/branches/create.json")
+	defer ts.Close()
+
+	branch, err := gitlab.CreateBranch("1", "release/1.2", "master")
+
+	assert.NoError(t, err)
+	assert.Equal(t, branch.Name, "release/1.2")
+	assert.Equal(t, branch.CanPush, true)
+	assert.Equal(t, branch.Commit.Id, "7b5c3cc8be40ee161ae89a06bba6229da1032a0c")
+}
+
+func TestDeleteBranch(t *testing.T) {
+	ts, gitlab := Stub("")
+	defer ts.Close()
+
+	assert.NoError(t, gitlab.DeleteBranch("1", "release/1.2"))
+	assert.NoError(t, gitlab.DeleteMergedBranches("1"))
+}
+
 func TestRepoTags(t *testing.T) {
 	ts, gitlab := Stub("stubs/tags/index.json")
 	tags, err := gitlab.RepoTags("1")
@@ -33,13 +72,119 @@ func TestRepoTags(t *testing.T) {
 	defer ts.Close()
 }
 
+func TestCreateTag(t *testing.T) {
+	ts, gitlab := Stub("stubs/tags/create.json")
+	defer ts.Close()
+
+	tag, err := gitlab.CreateTag("1", "v1.0.0", "master", "Release 1.0.0")
+
+	assert.NoError(t, err)
+	assert.Equal(t, tag.Name, "v1.0.0")
+	assert.Equal(t, tag.Message, "Release 1.0.0")
+	assert.Equal(t, tag.Release.Description, "Amazing release. Wow")
+}
+
+func TestDeleteTag(t *testing.T) {
+	ts, gitlab := Stub("")
+	defer ts.Close()
+
+	assert.NoError(t, gitlab.DeleteTag("1", "v1.0.0"))
+}
+
 func TestRepoCommits(t *testing.T) {
 	ts, gitlab := Stub("stubs/commits/index.json")
 	commits, err := gitlab.RepoCommits("1")
 
 	assert.Equal(t, err, nil)
 	assert.Equal(t, len(commits), 2)
+	assert.Equal(t, commits[0].AuthorName, "Dmitriy Zaporozhets")
+	assert.Equal(t, commits[0].CreatedAt.Day(), 20)
+	defer ts.Close()
+}
+
+func TestListCommits(t *testing.T) {
+	var query url.Values
+	stub, _ := ioutil.ReadFile("stubs/commits/index_with_stats.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	since := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	commits, err := gitlab.ListCommits("1", &ListCommitsOpts{
+		RefName:     "master",
+		Path:        "app/models",
+		Since:       &since,
+		WithStats:   true,
+		FirstParent: true,
+		Pagination:  Pagination{Page: 2, PerPage: 50},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, query.Get("ref_name"), "master")
+	assert.Equal(t, query.Get("path"), "app/models")
+	assert.Equal(t, query.Get("since"), "2021-09-01T00:00:00Z")
+	assert.Equal(t, query.Get("with_stats"), "true")
+	assert.Equal(t, query.Get("first_parent"), "true")
+	assert.Equal(t, query.Get("page"), "2")
+	assert.Equal(t, query.Get("all"), "")
+	assert.Equal(t, len(commits), 1)
+	assert.Equal(t, commits[0].ShortId, "ed899a2f4b5")
+	assert.Equal(t, commits[0].CommitterName, "Administrator")
+	assert.Equal(t, commits[0].ParentIds, []string{"6104942438c14ec7bd21c6cd5bd995272b3faff6"})
+	assert.Equal(t, commits[0].Stats.Additions, 3)
+	assert.Equal(t, commits[0].WebURL, "https://gitlab.example.com/janedoe/gitlab-foss/-/commit/ed899a2f4b50b4370feeea94676502b42383c746")
+
+	until := since.AddDate(0, -1, 0)
+	_, err = gitlab.ListCommits("1", &ListCommitsOpts{Since: &since, Until: &until})
+	assert.Error(t, err)
+}
+
+func TestCompareRefs(t *testing.T) {
+	ts, gitlab := Stub("stubs/repository/compare.json")
+	defer ts.Close()
+
+	c, err := gitlab.CompareRefs("1", "master", "feature", true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, c.Commit.Title, "JS fix")
+	assert.Equal(t, len(c.Commits), 1)
+	assert.Equal(t, len(c.Diffs), 1)
+	assert.Equal(t, c.Diffs[0].NewPath, "files/js/application.js")
+}
+
+func TestMergeBase(t *testing.T) {
+	var refs []string
+	stub, _ := ioutil.ReadFile("stubs/repository/merge_base.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		refs = r.URL.Query()["refs[]"]
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	c, err := gitlab.MergeBase("1", "304d257d", "0031876f")
+
+	assert.NoError(t, err)
+	assert.Equal(t, refs, []string{"304d257d", "0031876f"})
+	assert.Equal(t, c.Id, "1a0b36b3cdad1d2ee32457c102a8c0b7056fa863")
+
+	_, err = gitlab.MergeBase("1", "304d257d")
+	assert.Error(t, err)
+}
+
+func TestContributors(t *testing.T) {
+	ts, gitlab := Stub("stubs/repository/contributors.json")
 	defer ts.Close()
+
+	cs, err := gitlab.Contributors("1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(cs), 2)
+	assert.Equal(t, cs[0].Commits, 117)
+	assert.Equal(t, cs[1].Email, "sample@example.com")
 }
 
 func TestRepoTree(t *testing.T) {
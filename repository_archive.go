@@ -0,0 +1,224 @@
+package gogitlab
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/bzip2"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	repo_url_archive = "/projects/:id/repository/archive.:format" // Get an archive of the repository
+)
+
+const (
+	ArchiveTarGz  = "tar.gz"
+	ArchiveTarBz2 = "tar.bz2"
+	ArchiveTar    = "tar"
+	ArchiveZip    = "zip"
+)
+
+var validArchiveFormat = map[string]bool{
+	ArchiveTarGz:  true,
+	ArchiveTarBz2: true,
+	ArchiveTar:    true,
+	ArchiveZip:    true,
+}
+
+/*
+Get an archive of the repository, the caller must close the returned reader.
+
+    GET /projects/:id/repository/archive[.format]
+
+Parameters:
+
+    id     The ID of a project
+    sha    The commit SHA, branch or tag to download, the default branch if empty
+    format The archive format, `tar.gz` if empty
+    path   The subpath of the repository to download, all the repository if empty
+
+*/
+func (g *Gitlab) RepoArchive(id, sha, format, path string) (io.ReadCloser, error) {
+	if "" == format {
+		format = ArchiveTarGz
+	}
+	if !validArchiveFormat[format] {
+		return nil, fmt.Errorf("Check archive parameters error: Invalid format '%s'", format)
+	}
+
+	query := make(map[string]string)
+	if "" != sha {
+		query["sha"] = sha
+	}
+	if "" != path {
+		query["path"] = path
+	}
+
+	resp, err := g.execRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(repo_url_archive, map[string]string{":id": id, ":format": format}, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request archive API error: %v", err)
+	}
+
+	return resp.Body, nil
+}
+
+// Stream an archive of the repository to w, see RepoArchive for the parameters
+func (g *Gitlab) WriteRepoArchive(w io.Writer, id, sha, format, path string) (int64, error) {
+	r, err := g.RepoArchive(id, sha, format, path)
+	if nil != err {
+		return 0, err
+	}
+	defer r.Close()
+
+	return io.Copy(w, r)
+}
+
+/*
+Download an archive of the repository and extract it into dir, see
+RepoArchive for the other parameters. Only directories and regular files
+are extracted, GitLab puts them under a top level directory named after
+the project and the commit.
+*/
+func (g *Gitlab) ExtractRepoArchive(dir, id, sha, format, path string) error {
+	if "" == format {
+		format = ArchiveTarGz
+	}
+
+	r, err := g.RepoArchive(id, sha, format, path)
+	if nil != err {
+		return err
+	}
+	defer r.Close()
+
+	switch format {
+	case ArchiveTarGz:
+		gz, err := gzip.NewReader(r)
+		if nil != err {
+			return fmt.Errorf("Decode archive error: %v", err)
+		}
+		defer gz.Close()
+		return extractTar(dir, gz)
+	case ArchiveTarBz2:
+		return extractTar(dir, bzip2.NewReader(r))
+	case ArchiveTar:
+		return extractTar(dir, r)
+	default:
+		return extractZip(dir, r)
+	}
+}
+
+// Resolve the destination of an archive entry, refusing to escape dir
+func archiveTarget(dir, name string) (string, error) {
+	target := filepath.Join(dir, filepath.FromSlash(name))
+	rel, err := filepath.Rel(filepath.Clean(dir), target)
+	if nil != err || ".." == rel || strings.HasPrefix(rel, ".."+string(os.PathSeparator)) {
+		return "", fmt.Errorf("Invalid archive entry '%s'", name)
+	}
+	return target, nil
+}
+
+func extractFile(target string, mode os.FileMode, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); nil != err {
+		return err
+	}
+
+	f, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode.Perm())
+	if nil != err {
+		return err
+	}
+
+	if _, err := io.Copy(f, r); nil != err {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func extractTar(dir string, r io.Reader) error {
+	tr := tar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		if io.EOF == err {
+			return nil
+		}
+		if nil != err {
+			return fmt.Errorf("Decode archive error: %v", err)
+		}
+
+		target, err := archiveTarget(dir, h.Name)
+		if nil != err {
+			return err
+		}
+
+		switch h.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, 0755)
+		case tar.TypeReg:
+			err = extractFile(target, h.FileInfo().Mode(), tr)
+		}
+		if nil != err {
+			return fmt.Errorf("Extract archive error: %v", err)
+		}
+	}
+}
+
+func extractZip(dir string, r io.Reader) error {
+	// zip needs random access, buffer the archive in a temporary file
+	tmp, err := ioutil.TempFile("", "gitlab-archive-")
+	if nil != err {
+		return fmt.Errorf("Extract archive error: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	size, err := io.Copy(tmp, r)
+	if nil != err {
+		return fmt.Errorf("Download archive error: %v", err)
+	}
+
+	zr, err := zip.NewReader(tmp, size)
+	if nil != err {
+		return fmt.Errorf("Decode archive error: %v", err)
+	}
+
+	for _, f := range zr.File {
+		target, err := archiveTarget(dir, f.Name)
+		if nil != err {
+			return err
+		}
+
+		mode := f.Mode()
+		if mode.IsDir() {
+			err = os.MkdirAll(target, 0755)
+		} else if mode.IsRegular() {
+			err = extractZipFile(target, f)
+		}
+		if nil != err {
+			return fmt.Errorf("Extract archive error: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func extractZipFile(target string, f *zip.File) error {
+	rc, err := f.Open()
+	if nil != err {
+		return err
+	}
+	defer rc.Close()
+
+	return extractFile(target, f.Mode(), rc)
+}
@@ -0,0 +1,134 @@
+package gogitlab
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func archiveStub(data []byte) (*httptest.Server, *Gitlab, *string) {
+	requested := new(string)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requested = r.URL.Path + "?" + r.URL.RawQuery
+		w.Write(data)
+	}))
+	return ts, NewGitlab(ts.URL, "", ""), requested
+}
+
+func tarGzArchive(files map[string]string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	tw.WriteHeader(&tar.Header{Name: "project-master/", Typeflag: tar.TypeDir, Mode: 0755})
+	for name, content := range files {
+		tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(content))})
+		tw.Write([]byte(content))
+	}
+	tw.Close()
+	gz.Close()
+	return buf.Bytes()
+}
+
+func TestRepoArchive(t *testing.T) {
+	ts, gitlab, requested := archiveStub([]byte("archive"))
+	defer ts.Close()
+
+	var buf bytes.Buffer
+	n, err := gitlab.WriteRepoArchive(&buf, "1", "master", ArchiveZip, "docs")
+
+	assert.NoError(t, err)
+	assert.Equal(t, n, int64(7))
+	assert.Equal(t, buf.String(), "archive")
+	assert.Equal(t, *requested, "/projects/1/repository/archive.zip?path=docs&sha=master")
+
+	_, err = gitlab.RepoArchive("1", "master", "rar", "")
+	assert.Error(t, err)
+}
+
+func TestExtractRepoArchiveTarGz(t *testing.T) {
+	ts, gitlab, requested := archiveStub(tarGzArchive(map[string]string{
+		"project-master/README.md":   "# project\n",
+		"project-master/src/main.go": "package main\n",
+	}))
+	defer ts.Close()
+
+	dir, _ := ioutil.TempDir("", "archive-test-")
+	defer os.RemoveAll(dir)
+
+	err := gitlab.ExtractRepoArchive(dir, "1", "", "", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, *requested, "/projects/1/repository/archive.tar.gz?")
+	content, err := ioutil.ReadFile(filepath.Join(dir, "project-master", "src", "main.go"))
+	assert.NoError(t, err)
+	assert.Equal(t, string(content), "package main\n")
+}
+
+func TestExtractRepoArchiveZip(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, _ := zw.Create("project-master/docs/index.md")
+	w.Write([]byte("docs\n"))
+	zw.Close()
+
+	ts, gitlab, _ := archiveStub(buf.Bytes())
+	defer ts.Close()
+
+	dir, _ := ioutil.TempDir("", "archive-test-")
+	defer os.RemoveAll(dir)
+
+	err := gitlab.ExtractRepoArchive(dir, "1", "master", ArchiveZip, "")
+
+	assert.NoError(t, err)
+	content, err := ioutil.ReadFile(filepath.Join(dir, "project-master", "docs", "index.md"))
+	assert.NoError(t, err)
+	assert.Equal(t, string(content), "docs\n")
+}
+
+func TestExtractRepoArchiveOutsideDir(t *testing.T) {
+	ts, gitlab, _ := archiveStub(tarGzArchive(map[string]string{
+		"../evil": "evil",
+	}))
+	defer ts.Close()
+
+	dir, _ := ioutil.TempDir("", "archive-test-")
+	defer os.RemoveAll(dir)
+
+	err := gitlab.ExtractRepoArchive(dir, "1", "master", ArchiveTarGz, "")
+
+	assert.Error(t, err)
+	_, err = os.Stat(filepath.Join(filepath.Dir(dir), "evil"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestExtractRepoArchiveWorkingDir(t *testing.T) {
+	ts, gitlab, _ := archiveStub(tarGzArchive(map[string]string{
+		"project-master/README.md": "# project\n",
+	}))
+	defer ts.Close()
+
+	dir, _ := ioutil.TempDir("", "archive-test-")
+	defer os.RemoveAll(dir)
+	wd, _ := os.Getwd()
+	defer os.Chdir(wd)
+	assert.NoError(t, os.Chdir(dir))
+
+	for _, dest := range []string{".", ""} {
+		err := gitlab.ExtractRepoArchive(dest, "1", "master", ArchiveTarGz, "")
+
+		assert.NoError(t, err)
+		content, err := ioutil.ReadFile(filepath.Join(dir, "project-master", "README.md"))
+		assert.NoError(t, err)
+		assert.Equal(t, string(content), "# project\n")
+		os.RemoveAll(filepath.Join(dir, "project-master"))
+	}
+}
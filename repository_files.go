@@ -0,0 +1,298 @@
+package gogitlab
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+var (
+	repoFileUrl      = path.Join(project_url, "repository", "files", ":file_path")
+	repoFileRawUrl   = path.Join(project_url, "repository", "files", ":file_path", "raw")
+	repoFileBlameUrl = path.Join(project_url, "repository", "files", ":file_path", "blame")
+)
+
+const (
+	FileEncodingText   = "text"
+	FileEncodingBase64 = "base64"
+)
+
+// A file of a repository with its metadata
+type File struct {
+	FileName        string `json:"file_name"`
+	FilePath        string `json:"file_path"`
+	Size            int    `json:"size"`
+	Encoding        string `json:"encoding"`
+	Content         string `json:"content"`
+	ContentSHA256   string `json:"content_sha256"`
+	ExecuteFilemode bool   `json:"execute_filemode"`
+	Ref             string `json:"ref"`
+	BlobId          string `json:"blob_id"`
+	CommitId        string `json:"commit_id"`
+	LastCommitId    string `json:"last_commit_id"`
+	// Content decoded according to Encoding
+	Data []byte `json:"-"`
+}
+
+// Commit parameters shared by every file modification
+type FileCommitOpts struct {
+	Branch        string `json:"branch"`
+	StartBranch   string `json:"start_branch,omitempty"`
+	CommitMessage string `json:"commit_message"`
+	AuthorEmail   string `json:"author_email,omitempty"`
+	AuthorName    string `json:"author_name,omitempty"`
+	// Fails the commit when the file was changed since this commit
+	LastCommitId string `json:"last_commit_id,omitempty"`
+}
+
+type FileOpts struct {
+	FileCommitOpts
+	Content         string `json:"content"`
+	Encoding        string `json:"encoding,omitempty"`
+	ExecuteFilemode *bool  `json:"execute_filemode,omitempty"`
+}
+
+// Result of a file modification
+type FileInfo struct {
+	FilePath string `json:"file_path"`
+	Branch   string `json:"branch"`
+}
+
+type BlameOpts struct {
+	Ref string
+	// Lines range to blame, starting at 1, both ends are included
+	RangeStart int
+	RangeEnd   int
+}
+
+// A range of consecutive lines last modified by the same commit
+type BlameRange struct {
+	Commit *Commit  `json:"commit"`
+	Lines  []string `json:"lines"`
+}
+
+func (opts *FileCommitOpts) check() error {
+	if nil == opts {
+		return fmt.Errorf("Missing commit parameters")
+	}
+
+	if "" == opts.Branch {
+		return fmt.Errorf("Missing branch")
+	}
+
+	if "" == opts.CommitMessage {
+		return fmt.Errorf("Missing commit_message")
+	}
+
+	return nil
+}
+
+func (opts *FileCommitOpts) toQuery() map[string]string {
+	query := map[string]string{
+		"branch":         opts.Branch,
+		"commit_message": opts.CommitMessage,
+	}
+	if "" != opts.StartBranch {
+		query["start_branch"] = opts.StartBranch
+	}
+	if "" != opts.AuthorEmail {
+		query["author_email"] = opts.AuthorEmail
+	}
+	if "" != opts.AuthorName {
+		query["author_name"] = opts.AuthorName
+	}
+	if "" != opts.LastCommitId {
+		query["last_commit_id"] = opts.LastCommitId
+	}
+	return query
+}
+
+func (opts *FileOpts) check() error {
+	if nil == opts {
+		return fmt.Errorf("Missing file parameters")
+	}
+
+	if "" != opts.Encoding && FileEncodingText != opts.Encoding && FileEncodingBase64 != opts.Encoding {
+		return fmt.Errorf("Invalid encoding '%s'", opts.Encoding)
+	}
+
+	return opts.FileCommitOpts.check()
+}
+
+func (opts *BlameOpts) toQuery() (map[string]string, error) {
+	if nil == opts {
+		return nil, nil
+	}
+
+	if opts.RangeStart < 0 || opts.RangeEnd < 0 {
+		return nil, fmt.Errorf("Invalid range '%d-%d'", opts.RangeStart, opts.RangeEnd)
+	}
+
+	if (0 == opts.RangeStart) != (0 == opts.RangeEnd) || opts.RangeEnd < opts.RangeStart {
+		return nil, fmt.Errorf("Invalid range '%d-%d'", opts.RangeStart, opts.RangeEnd)
+	}
+
+	query := make(map[string]string)
+	if "" != opts.Ref {
+		query["ref"] = opts.Ref
+	}
+	if opts.RangeStart > 0 {
+		query["range[start]"] = strconv.Itoa(opts.RangeStart)
+		query["range[end]"] = strconv.Itoa(opts.RangeEnd)
+	}
+	return query, nil
+}
+
+func (f *File) decode() error {
+	switch f.Encoding {
+	case FileEncodingBase64:
+		data, err := base64.StdEncoding.DecodeString(f.Content)
+		if nil != err {
+			return err
+		}
+		f.Data = data
+	default:
+		f.Data = []byte(f.Content)
+	}
+	return nil
+}
+
+// Get a file with its metadata, its content is decoded into File.Data
+func (g *Gitlab) GetFile(pid, filePath, ref string) (*File, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(
+			repoFileUrl,
+			map[string]string{":id": pid, ":file_path": url.PathEscape(filePath)},
+			map[string]string{"ref": ref},
+		),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request get file API error: %v", err)
+	}
+
+	var f *File
+	if err := json.Unmarshal(data, &f); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	if err := f.decode(); nil != err {
+		return nil, fmt.Errorf("Decode file content error: %v", err)
+	}
+
+	return f, nil
+}
+
+// Stream the raw content of a file, the caller must close the returned reader.
+// The default branch is used when ref is empty.
+func (g *Gitlab) GetRawFile(pid, filePath, ref string) (io.ReadCloser, error) {
+	var query map[string]string
+	if "" != ref {
+		query = map[string]string{"ref": ref}
+	}
+
+	resp, err := g.execRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(
+			repoFileRawUrl,
+			map[string]string{":id": pid, ":file_path": url.PathEscape(filePath)},
+			query,
+		),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request get raw file API error: %v", err)
+	}
+
+	return resp.Body, nil
+}
+
+func (g *Gitlab) CreateFile(pid, filePath string, opts *FileOpts) (*FileInfo, error) {
+	return g.saveFile(http.MethodPost, pid, filePath, opts)
+}
+
+func (g *Gitlab) UpdateFile(pid, filePath string, opts *FileOpts) (*FileInfo, error) {
+	return g.saveFile(http.MethodPut, pid, filePath, opts)
+}
+
+func (g *Gitlab) saveFile(method, pid, filePath string, opts *FileOpts) (*FileInfo, error) {
+	if err := opts.check(); nil != err {
+		return nil, fmt.Errorf("Check file parameters error: %v", err)
+	}
+
+	body, err := json.Marshal(opts)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		method,
+		g.ResourceUrl(repoFileUrl, map[string]string{":id": pid, ":file_path": url.PathEscape(filePath)}),
+		body,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request save file API error: %v", err)
+	}
+
+	var info *FileInfo
+	if err := json.Unmarshal(data, &info); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return info, nil
+}
+
+func (g *Gitlab) DeleteFile(pid, filePath string, opts *FileCommitOpts) error {
+	if err := opts.check(); nil != err {
+		return fmt.Errorf("Check file parameters error: %v", err)
+	}
+
+	_, err := g.buildAndExecRequest(
+		http.MethodDelete,
+		g.ResourceUrlWithQuery(
+			repoFileUrl,
+			map[string]string{":id": pid, ":file_path": url.PathEscape(filePath)},
+			opts.toQuery(),
+		),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request delete file API error: %v", err)
+	}
+
+	return err
+}
+
+// Get the commits which last modified each range of lines of a file
+func (g *Gitlab) GetFileBlame(pid, filePath string, opts *BlameOpts) ([]*BlameRange, error) {
+	query, err := opts.toQuery()
+	if nil != err {
+		return nil, fmt.Errorf("Check blame parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(
+			repoFileBlameUrl,
+			map[string]string{":id": pid, ":file_path": url.PathEscape(filePath)},
+			query,
+		),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request get file blame API error: %v", err)
+	}
+
+	var rs []*BlameRange
+	if err := json.Unmarshal(data, &rs); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return rs, nil
+}
@@ -0,0 +1,95 @@
+package gogitlab
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetFile(t *testing.T) {
+	var requested string
+	stub, _ := ioutil.ReadFile("stubs/files/show.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.EscapedPath()
+		w.Write(stub)
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	f, err := gitlab.GetFile("1", "app/models/key.rb", "master")
+
+	assert.NoError(t, err)
+	assert.Equal(t, requested, "/projects/1/repository/files/app%2Fmodels%2Fkey.rb")
+	assert.Equal(t, f.BlobId, "79f7bbd25901e8334750839545a9bd021f0e4c83")
+	assert.Equal(t, f.LastCommitId, "570e7b2abdd848b95f2f578043fc23bd6f6fd24d")
+	assert.Equal(t, f.Size, 25)
+	assert.Equal(t, string(f.Data), "require 'digest/md5'\n")
+}
+
+func TestGetRawFile(t *testing.T) {
+	ts, gitlab := Stub("stubs/files/raw.txt")
+	defer ts.Close()
+
+	r, err := gitlab.GetRawFile("1", "app/models/key.rb", "")
+	assert.NoError(t, err)
+	defer r.Close()
+
+	contents, err := ioutil.ReadAll(r)
+
+	assert.NoError(t, err)
+	assert.Equal(t, string(contents), "require 'digest/md5'\n")
+}
+
+func TestCreateFile(t *testing.T) {
+	ts, gitlab := Stub("stubs/files/create.json")
+	defer ts.Close()
+
+	info, err := gitlab.CreateFile("1", "app/project.rb", &FileOpts{
+		FileCommitOpts: FileCommitOpts{Branch: "master", CommitMessage: "create a new file"},
+		Content:        "some content",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, info.FilePath, "app/project.rb")
+
+	_, err = gitlab.UpdateFile("1", "app/project.rb", &FileOpts{Content: "some content"})
+	assert.Error(t, err)
+
+	_, err = gitlab.UpdateFile("1", "app/project.rb", &FileOpts{
+		FileCommitOpts: FileCommitOpts{Branch: "master", CommitMessage: "update"},
+		Encoding:       "gzip",
+	})
+	assert.Error(t, err)
+}
+
+func TestDeleteFile(t *testing.T) {
+	var query string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("commit_message")
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	err := gitlab.DeleteFile("1", "app/project.rb", &FileCommitOpts{Branch: "master", CommitMessage: "delete file"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, query, "delete file")
+}
+
+func TestGetFileBlame(t *testing.T) {
+	ts, gitlab := Stub("stubs/files/blame.json")
+	defer ts.Close()
+
+	rs, err := gitlab.GetFileBlame("1", "app/models/key.rb", &BlameOpts{Ref: "master", RangeStart: 1, RangeEnd: 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(rs), 1)
+	assert.Equal(t, rs[0].Commit.Id, "d42409d56517157c48bf3bd97d3f75974dde19fb")
+	assert.Equal(t, rs[0].Lines, []string{"require 'digest/md5'"})
+
+	_, err = gitlab.GetFileBlame("1", "app/models/key.rb", &BlameOpts{RangeStart: 3, RangeEnd: 2})
+	assert.Error(t, err)
+}
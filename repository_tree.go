@@ -0,0 +1,193 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+const (
+	TreeNodeBlob   = "blob"
+	TreeNodeTree   = "tree"
+	TreeNodeCommit = "commit" // A git submodule
+)
+
+type ListTreeOpts struct {
+	// The path inside repository, the root if empty
+	Path string
+	// The branch, tag or commit, the default branch if empty
+	Ref       string
+	Recursive bool
+	Pagination
+}
+
+type WalkRepoOpts struct {
+	// Only walk the files under this path
+	Path string
+	// Fetch the content of every file with RepoRawFile
+	FetchContent bool
+	// Number of contents fetched concurrently, 4 if not set
+	Concurrency int
+}
+
+/*
+Called by WalkRepo for every file of the repository, in the order
+GitLab lists them. content is nil unless WalkRepoOpts.FetchContent
+is set. Returning an error stops the walk, WalkRepo returns it.
+*/
+type WalkRepoFunc func(node *TreeNode, content []byte) error
+
+func (opts *ListTreeOpts) toQuery() (map[string]string, error) {
+	if nil == opts {
+		return nil, nil
+	}
+
+	if err := opts.Pagination.check(); nil != err {
+		return nil, err
+	}
+
+	query := make(map[string]string)
+	if "" != opts.Path {
+		query["path"] = opts.Path
+	}
+	if "" != opts.Ref {
+		query["ref"] = opts.Ref
+	}
+	if opts.Recursive {
+		query["recursive"] = "true"
+	}
+	opts.Pagination.toQuery(query)
+	return query, nil
+}
+
+// Get a list of repository files and directories in a project
+func (g *Gitlab) ListTree(id string, opts *ListTreeOpts) ([]*TreeNode, error) {
+	query, err := opts.toQuery()
+	if nil != err {
+		return nil, fmt.Errorf("Check list tree parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(repo_url_tree, map[string]string{":id": id}, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list tree API error: %v", err)
+	}
+
+	var nodes []*TreeNode
+	if err := json.Unmarshal(data, &nodes); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return nodes, nil
+}
+
+// Get every file of the repository, following pagination
+func (g *Gitlab) repoFiles(id, ref, path string) ([]*TreeNode, error) {
+	var files []*TreeNode
+	opts := &ListTreeOpts{
+		Path:       path,
+		Ref:        ref,
+		Recursive:  true,
+		Pagination: Pagination{Page: 1, PerPage: 100},
+	}
+	for {
+		nodes, err := g.ListTree(id, opts)
+		if nil != err {
+			return nil, err
+		}
+		for _, n := range nodes {
+			if TreeNodeBlob == n.Type {
+				files = append(files, n)
+			}
+		}
+		if len(nodes) < opts.PerPage {
+			return files, nil
+		}
+		opts.Page++
+	}
+}
+
+type walkResult struct {
+	content []byte
+	err     error
+}
+
+/*
+Visit every file of the repository at ref with fn, see WalkRepoFunc.
+
+When opts.FetchContent is set the contents are fetched concurrently
+but fn is still called sequentially, in the listing order.
+*/
+func (g *Gitlab) WalkRepo(id, ref string, fn WalkRepoFunc, opts *WalkRepoOpts) error {
+	if nil == opts {
+		opts = &WalkRepoOpts{}
+	}
+
+	files, err := g.repoFiles(id, ref, opts.Path)
+	if nil != err {
+		return err
+	}
+
+	if !opts.FetchContent {
+		for _, f := range files {
+			if err := fn(f, nil); nil != err {
+				return err
+			}
+		}
+		return nil
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	results := make([]chan walkResult, len(files))
+	for i := range results {
+		results[i] = make(chan walkResult, 1)
+	}
+
+	jobs := make(chan int)
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				content, err := g.RepoRawFile(id, ref, files[i].Path)
+				results[i] <- walkResult{content, err}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for i := range files {
+			select {
+			case jobs <- i:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	defer wg.Wait()
+	defer close(done)
+
+	for i, f := range files {
+		r := <-results[i]
+		if nil != r.err {
+			return fmt.Errorf("Fetch content of '%s' error: %v", f.Path, r.err)
+		}
+		if err := fn(f, r.content); nil != err {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package gogitlab
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func treeStub() (*httptest.Server, *Gitlab) {
+	stub, _ := ioutil.ReadFile("stubs/trees/recursive.json")
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/repository/tree") {
+			w.Write(stub)
+			return
+		}
+		w.Write([]byte("content of " + r.URL.Query().Get("filepath")))
+	}))
+	return ts, NewGitlab(ts.URL, "", "")
+}
+
+func TestListTree(t *testing.T) {
+	ts, gitlab := Stub("stubs/trees/recursive.json")
+	defer ts.Close()
+
+	nodes, err := gitlab.ListTree("1", &ListTreeOpts{Ref: "master", Recursive: true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(nodes), 4)
+	assert.Equal(t, nodes[1].Path, "files/html/index.html")
+	assert.Equal(t, nodes[1].Type, TreeNodeBlob)
+	assert.Equal(t, nodes[2].Mode, "100755")
+}
+
+func TestWalkRepo(t *testing.T) {
+	ts, gitlab := treeStub()
+	defer ts.Close()
+
+	var paths []string
+	err := gitlab.WalkRepo("1", "master", func(node *TreeNode, content []byte) error {
+		assert.Nil(t, content)
+		paths = append(paths, node.Path)
+		return nil
+	}, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, paths, []string{"files/html/index.html", "files/run.sh", "README.md"})
+}
+
+func TestWalkRepoFetchContent(t *testing.T) {
+	ts, gitlab := treeStub()
+	defer ts.Close()
+
+	var contents []string
+	err := gitlab.WalkRepo("1", "master", func(node *TreeNode, content []byte) error {
+		contents = append(contents, string(content))
+		return nil
+	}, &WalkRepoOpts{FetchContent: true, Concurrency: 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, contents, []string{
+		"content of files/html/index.html",
+		"content of files/run.sh",
+		"content of README.md",
+	})
+
+	stop := errors.New("stop")
+	visited := 0
+	err = gitlab.WalkRepo("1", "master", func(node *TreeNode, content []byte) error {
+		visited++
+		return stop
+	}, &WalkRepoOpts{FetchContent: true})
+
+	assert.Equal(t, err, stop)
+	assert.Equal(t, visited, 1)
+}
@@ -0,0 +1,56 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+)
+
+// The time tracked on an issue or a merge request, durations are in seconds
+type TimeStats struct {
+	TimeEstimate        int    `json:"time_estimate"`
+	TotalTimeSpent      int    `json:"total_time_spent"`
+	HumanTimeEstimate   string `json:"human_time_estimate"`
+	HumanTotalTimeSpent string `json:"human_total_time_spent"`
+}
+
+// Call one of the time tracking endpoints of the issue or merge request at u
+func (g *Gitlab) execTimeStats(method, u, action string, params, query map[string]string) (*TimeStats, error) {
+	data, err := g.buildAndExecRequest(
+		method,
+		g.ResourceUrlWithQuery(path.Join(u, action), params, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request %s API error: %v", action, err)
+	}
+
+	var s *TimeStats
+	if err := json.Unmarshal(data, &s); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return s, nil
+}
+
+// Durations are in the human format of GitLab, like 3h30m or 1w2d
+func (g *Gitlab) setTimeEstimate(u string, params map[string]string, duration string) (*TimeStats, error) {
+	if "" == duration {
+		return nil, fmt.Errorf("Check time_estimate parameters error: Missing duration")
+	}
+	return g.execTimeStats(http.MethodPost, u, "time_estimate", params, map[string]string{"duration": duration})
+}
+
+func (g *Gitlab) addSpentTime(u string, params map[string]string, duration, summary string) (*TimeStats, error) {
+	if "" == duration {
+		return nil, fmt.Errorf("Check add_spent_time parameters error: Missing duration")
+	}
+
+	query := map[string]string{"duration": duration}
+	if "" != summary {
+		query["summary"] = summary
+	}
+
+	return g.execTimeStats(http.MethodPost, u, "add_spent_time", params, query)
+}
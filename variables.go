@@ -0,0 +1,333 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"sort"
+)
+
+var (
+	projectVariablesUrl = path.Join(project_url, "variables")
+	projectVariableUrl  = path.Join(project_url, "variables", ":key")
+	groupVariablesUrl   = path.Join(group_url, "variables")
+	groupVariableUrl    = path.Join(group_url, "variables", ":key")
+)
+
+const (
+	VariableTypeEnv  = "env_var"
+	VariableTypeFile = "file"
+
+	// EnvironmentScopeAll is the scope used by GitLab when none is given.
+	EnvironmentScopeAll = "*"
+)
+
+// A CI/CD variable of a project or a group
+type Variable struct {
+	Key              string `json:"key"`
+	Value            string `json:"value"`
+	VariableType     string `json:"variable_type,omitempty"`
+	Protected        bool   `json:"protected"`
+	Masked           bool   `json:"masked"`
+	EnvironmentScope string `json:"environment_scope,omitempty"`
+}
+
+type ListVariablesOpts struct {
+	Pagination
+}
+
+// Result of a variables synchronization
+type VariablesDiff struct {
+	Created []*Variable
+	Updated []*Variable
+	Removed []*Variable
+}
+
+func (d *VariablesDiff) Empty() bool {
+	return len(d.Created) == 0 && len(d.Updated) == 0 && len(d.Removed) == 0
+}
+
+var validVariableKey = regexp.MustCompile(`^[a-zA-Z0-9_]{1,255}$`)
+
+func (v *Variable) check() error {
+	if nil == v {
+		return fmt.Errorf("Missing variable")
+	}
+
+	if !validVariableKey.MatchString(v.Key) {
+		return fmt.Errorf("Invalid key '%s'", v.Key)
+	}
+
+	if "" != v.VariableType && VariableTypeEnv != v.VariableType && VariableTypeFile != v.VariableType {
+		return fmt.Errorf("Invalid variable_type '%s'", v.VariableType)
+	}
+
+	return nil
+}
+
+func (v *Variable) scope() string {
+	if "" == v.EnvironmentScope {
+		return EnvironmentScopeAll
+	}
+	return v.EnvironmentScope
+}
+
+func (v *Variable) variableType() string {
+	if "" == v.VariableType {
+		return VariableTypeEnv
+	}
+	return v.VariableType
+}
+
+func (v *Variable) sameAs(o *Variable) bool {
+	return v.Value == o.Value &&
+		v.variableType() == o.variableType() &&
+		v.Protected == o.Protected &&
+		v.Masked == o.Masked
+}
+
+func (opts *ListVariablesOpts) toQuery() (map[string]string, error) {
+	if nil == opts {
+		return nil, nil
+	}
+
+	if err := opts.Pagination.check(); nil != err {
+		return nil, err
+	}
+
+	query := make(map[string]string)
+	opts.Pagination.toQuery(query)
+	return query, nil
+}
+
+func scopeQuery(scope string) map[string]string {
+	if "" == scope {
+		return nil
+	}
+	return map[string]string{"filter[environment_scope]": scope}
+}
+
+func (g *Gitlab) listVariables(u string, params map[string]string, opts *ListVariablesOpts) ([]*Variable, error) {
+	query, err := opts.toQuery()
+	if nil != err {
+		return nil, fmt.Errorf("Check list variables parameters error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(u, params, query),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request list variables API error: %v", err)
+	}
+
+	var vs []*Variable
+	if err := json.Unmarshal(data, &vs); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return vs, nil
+}
+
+func (g *Gitlab) getVariable(u string, params map[string]string, scope string) (*Variable, error) {
+	data, err := g.buildAndExecRequest(
+		http.MethodGet,
+		g.ResourceUrlWithQuery(u, params, scopeQuery(scope)),
+		nil,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request get variable API error: %v", err)
+	}
+
+	var v *Variable
+	if err := json.Unmarshal(data, &v); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return v, nil
+}
+
+func (g *Gitlab) saveVariable(method, u string, params map[string]string, scope string, v *Variable) (*Variable, error) {
+	if err := v.check(); nil != err {
+		return nil, fmt.Errorf("Check variable parameters error: %v", err)
+	}
+
+	body, err := json.Marshal(v)
+	if nil != err {
+		return nil, fmt.Errorf("Encode request error: %v", err)
+	}
+
+	data, err := g.buildAndExecRequest(
+		method,
+		g.ResourceUrlWithQuery(u, params, scopeQuery(scope)),
+		body,
+	)
+	if nil != err {
+		return nil, fmt.Errorf("Request save variable API error: %v", err)
+	}
+
+	var saved *Variable
+	if err := json.Unmarshal(data, &saved); nil != err {
+		return nil, fmt.Errorf("Decode response error: %v", err)
+	}
+
+	return saved, nil
+}
+
+func (g *Gitlab) removeVariable(u string, params map[string]string, scope string) error {
+	_, err := g.buildAndExecRequest(
+		http.MethodDelete,
+		g.ResourceUrlWithQuery(u, params, scopeQuery(scope)),
+		nil,
+	)
+	if nil != err {
+		err = fmt.Errorf("Request remove variable API error: %v", err)
+	}
+
+	return err
+}
+
+// List the variables of a project
+func (g *Gitlab) ProjectVariables(pid string, opts *ListVariablesOpts) ([]*Variable, error) {
+	return g.listVariables(projectVariablesUrl, map[string]string{":id": pid}, opts)
+}
+
+// Get a project variable, scope may be empty when the key is not
+// defined for several environments
+func (g *Gitlab) ProjectVariable(pid, key, scope string) (*Variable, error) {
+	return g.getVariable(projectVariableUrl, map[string]string{":id": pid, ":key": key}, scope)
+}
+
+func (g *Gitlab) CreateProjectVariable(pid string, v *Variable) (*Variable, error) {
+	return g.saveVariable(http.MethodPost, projectVariablesUrl, map[string]string{":id": pid}, "", v)
+}
+
+// Update the project variable v.Key within the environment scope of v
+func (g *Gitlab) UpdateProjectVariable(pid string, v *Variable) (*Variable, error) {
+	if nil == v {
+		return nil, fmt.Errorf("Check variable parameters error: Missing variable")
+	}
+	return g.saveVariable(http.MethodPut, projectVariableUrl, map[string]string{":id": pid, ":key": v.Key}, v.EnvironmentScope, v)
+}
+
+func (g *Gitlab) RemoveProjectVariable(pid, key, scope string) error {
+	return g.removeVariable(projectVariableUrl, map[string]string{":id": pid, ":key": key}, scope)
+}
+
+// List the variables of a group
+func (g *Gitlab) GroupVariables(gid string, opts *ListVariablesOpts) ([]*Variable, error) {
+	return g.listVariables(groupVariablesUrl, map[string]string{":id": gid}, opts)
+}
+
+func (g *Gitlab) GroupVariable(gid, key string) (*Variable, error) {
+	return g.getVariable(groupVariableUrl, map[string]string{":id": gid, ":key": key}, "")
+}
+
+func (g *Gitlab) CreateGroupVariable(gid string, v *Variable) (*Variable, error) {
+	return g.saveVariable(http.MethodPost, groupVariablesUrl, map[string]string{":id": gid}, "", v)
+}
+
+func (g *Gitlab) UpdateGroupVariable(gid string, v *Variable) (*Variable, error) {
+	if nil == v {
+		return nil, fmt.Errorf("Check variable parameters error: Missing variable")
+	}
+	return g.saveVariable(http.MethodPut, groupVariableUrl, map[string]string{":id": gid, ":key": v.Key}, "", v)
+}
+
+func (g *Gitlab) RemoveGroupVariable(gid, key string) error {
+	return g.removeVariable(groupVariableUrl, map[string]string{":id": gid, ":key": key}, "")
+}
+
+// Fetch every variable of a project, following pagination
+func (g *Gitlab) allProjectVariables(pid string) ([]*Variable, error) {
+	var all []*Variable
+	opts := &ListVariablesOpts{Pagination{Page: 1, PerPage: 100}}
+	for {
+		vs, err := g.ProjectVariables(pid, opts)
+		if nil != err {
+			return nil, err
+		}
+		all = append(all, vs...)
+		if len(vs) < opts.PerPage {
+			return all, nil
+		}
+		opts.Page++
+	}
+}
+
+/*
+Reconcile the variables of a project with desired, which is keyed by
+variable key. A variable is identified by its key and environment scope:
+missing ones are created, changed ones are updated and those which are
+not desired any more are removed.
+
+The returned diff lists the applied changes, it is also returned
+partially filled when an error interrupts the synchronization.
+*/
+func (g *Gitlab) SyncProjectVariables(pid string, desired map[string]*Variable) (*VariablesDiff, error) {
+	diff := &VariablesDiff{}
+
+	wanted := make(map[string]*Variable, len(desired))
+	keys := make([]string, 0, len(desired))
+	for key, v := range desired {
+		if nil == v {
+			return diff, fmt.Errorf("Missing variable '%s'", key)
+		}
+		d := *v
+		d.Key = key
+		if err := d.check(); nil != err {
+			return diff, fmt.Errorf("Check variable parameters error: %v", err)
+		}
+		wanted[key+"@"+d.scope()] = &d
+		keys = append(keys, key+"@"+d.scope())
+	}
+	sort.Strings(keys)
+
+	existing, err := g.allProjectVariables(pid)
+	if nil != err {
+		return diff, err
+	}
+
+	current := make(map[string]*Variable, len(existing))
+	for _, v := range existing {
+		id := v.Key + "@" + v.scope()
+		current[id] = v
+		if _, ok := wanted[id]; ok {
+			continue
+		}
+		if err := g.RemoveProjectVariable(pid, v.Key, v.scope()); nil != err {
+			return diff, err
+		}
+		diff.Removed = append(diff.Removed, v)
+	}
+
+	for _, id := range keys {
+		d := wanted[id]
+		cur, ok := current[id]
+		if ok && cur.sameAs(d) {
+			continue
+		}
+
+		var saved *Variable
+		if ok {
+			d.EnvironmentScope = cur.scope()
+			saved, err = g.UpdateProjectVariable(pid, d)
+		} else {
+			saved, err = g.CreateProjectVariable(pid, d)
+		}
+		if nil != err {
+			return diff, err
+		}
+
+		if ok {
+			diff.Updated = append(diff.Updated, saved)
+		} else {
+			diff.Created = append(diff.Created, saved)
+		}
+	}
+
+	return diff, nil
+}
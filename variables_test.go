@@ -0,0 +1,118 @@
+package gogitlab
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectVariables(t *testing.T) {
+	ts, gitlab := Stub("stubs/variables/index.json")
+	defer ts.Close()
+
+	vs, err := gitlab.ProjectVariables("1", nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(vs), 2)
+	assert.Equal(t, vs[0].Masked, true)
+	assert.Equal(t, vs[1].VariableType, VariableTypeFile)
+	assert.Equal(t, vs[1].EnvironmentScope, "production")
+}
+
+func TestProjectVariable(t *testing.T) {
+	ts, gitlab := Stub("stubs/variables/show.json")
+	defer ts.Close()
+
+	v, err := gitlab.ProjectVariable("1", "TEST_VARIABLE_1", "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, v.Key, "TEST_VARIABLE_1")
+	assert.Equal(t, v.Value, "TEST_1")
+}
+
+func TestCreateProjectVariable(t *testing.T) {
+	ts, gitlab := Stub("stubs/variables/show.json")
+	defer ts.Close()
+
+	v, err := gitlab.CreateProjectVariable("1", &Variable{Key: "TEST_VARIABLE_1", Value: "TEST_1"})
+	assert.NoError(t, err)
+	assert.Equal(t, v.Key, "TEST_VARIABLE_1")
+
+	_, err = gitlab.CreateProjectVariable("1", &Variable{Key: "NOT-VALID"})
+	assert.Error(t, err)
+
+	_, err = gitlab.CreateProjectVariable("1", &Variable{Key: "VALID", VariableType: "bogus"})
+	assert.Error(t, err)
+}
+
+func TestGroupVariables(t *testing.T) {
+	ts, gitlab := Stub("stubs/variables/index.json")
+	defer ts.Close()
+
+	vs, err := gitlab.GroupVariables("1", &ListVariablesOpts{Pagination{PerPage: 50}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, len(vs), 2)
+}
+
+func TestRemoveGroupVariable(t *testing.T) {
+	ts, gitlab := Stub("")
+	defer ts.Close()
+
+	err := gitlab.RemoveGroupVariable("1", "TEST_VARIABLE_1")
+
+	assert.NoError(t, err)
+}
+
+func TestSyncProjectVariables(t *testing.T) {
+	stub, _ := ioutil.ReadFile("stubs/variables/index.json")
+	var calls []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.Path+" "+r.URL.Query().Get("filter[environment_scope]"))
+		switch r.Method {
+		case http.MethodGet:
+			w.Write(stub)
+		case http.MethodDelete:
+		default:
+			body, _ := ioutil.ReadAll(r.Body)
+			w.Write(body)
+		}
+	}))
+	defer ts.Close()
+	gitlab := NewGitlab(ts.URL, "", "")
+
+	diff, err := gitlab.SyncProjectVariables("1", map[string]*Variable{
+		"TEST_VARIABLE_1": {Value: "CHANGED", Masked: true},
+		"TEST_VARIABLE_3": {Value: "NEW"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"GET /projects/1/variables ",
+		"DELETE /projects/1/variables/TEST_VARIABLE_2 production",
+		"PUT /projects/1/variables/TEST_VARIABLE_1 *",
+		"POST /projects/1/variables ",
+	}, calls)
+	assert.Equal(t, len(diff.Removed), 1)
+	assert.Equal(t, diff.Removed[0].Key, "TEST_VARIABLE_2")
+	assert.Equal(t, len(diff.Updated), 1)
+	assert.Equal(t, diff.Updated[0].Value, "CHANGED")
+	assert.Equal(t, len(diff.Created), 1)
+	assert.Equal(t, diff.Created[0].Key, "TEST_VARIABLE_3")
+
+	calls = nil
+	var unchanged []*Variable
+	json.Unmarshal(stub, &unchanged)
+	diff, err = gitlab.SyncProjectVariables("1", map[string]*Variable{
+		"TEST_VARIABLE_1": unchanged[0],
+		"TEST_VARIABLE_2": unchanged[1],
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, diff.Empty())
+	assert.Equal(t, len(calls), 1)
+}